@@ -0,0 +1,141 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// freshVariable describes the variable that the fresh address x points to, like
+// `new variable of type "T"` for `&T{}` or `".Field" of new variable of type "T"` for `&(&T{}).Field`.
+// The element type t is used when x does not point into a larger allocation.
+func (p pass) freshVariable(x ast.Expr, t types.Type, zeroSized bool) string {
+	if root, path, ok := p.freshPath(x); ok && path != "" {
+		if zeroSized {
+			return fmt.Sprintf("zero-sized %q of new variable of type %q", path, p.typeString(root))
+		}
+
+		return fmt.Sprintf("%q of new variable of type %q", path, p.typeString(root))
+	}
+
+	if zeroSized {
+		return fmt.Sprintf("new zero-sized variable of type %q", p.typeString(t))
+	}
+
+	return fmt.Sprintf("new variable of type %q", p.typeString(t))
+}
+
+// freshPath returns the type of the fresh allocation that x points into and the access path
+// from it, following the same forms as [pass.isAddrOfCompLitOrNew].
+func (p pass) freshPath(x ast.Expr) (root types.Type, path string, ok bool) {
+	switch e := p.unconvert(x).(type) {
+	case *ast.UnaryExpr:
+		if _, isLit := ast.Unparen(e.X).(*ast.CompositeLit); e.Op == token.AND && !isLit {
+			return p.freshAccess(e.X)
+		}
+
+	case *ast.CallExpr:
+		if len(e.Args) == 1 && !p.TypesInfo.Types[e.Fun].IsBuiltin() {
+			if _, ok := p.isHandleOfNew(e); ok {
+				return p.freshPath(e.Args[0]) // weak.Make(&(&T{}).Field)
+			}
+		}
+	}
+
+	return nil, "", false
+}
+
+// freshAccess checks if the addressable expression x is a field or element of a fresh allocation,
+// like `(&T{}).Field`, `new(T).Inner`, `[]T{x}[0]` or `new([3]T)[i]`.
+// It returns the type of the allocation and the access path from it.
+func (p pass) freshAccess(x ast.Expr) (root types.Type, path string, ok bool) {
+	switch e := ast.Unparen(x).(type) {
+	case *ast.SelectorExpr:
+		sel, ok := p.TypesInfo.Selections[e]
+		if !ok || sel.Kind() != types.FieldVal || embedsPointer(sel) {
+			return nil, "", false
+		}
+
+		if _, isPtr := sel.Recv().Underlying().(*types.Pointer); isPtr {
+			root, path, ok = p.freshPointer(e.X)
+		} else {
+			root, path, ok = p.freshAccess(e.X)
+		}
+
+		return root, path + "." + e.Sel.Name, ok
+
+	case *ast.IndexExpr:
+		switch p.TypesInfo.TypeOf(e.X).Underlying().(type) {
+		case *types.Slice:
+			if cl, isLit := ast.Unparen(e.X).(*ast.CompositeLit); isLit {
+				root, ok = p.TypesInfo.TypeOf(cl), true
+			}
+
+		case *types.Pointer:
+			root, path, ok = p.freshPointer(e.X)
+
+		case *types.Array:
+			root, path, ok = p.freshAccess(e.X)
+		}
+
+		return root, path + "[" + p.exprToString(e.Index) + "]", ok
+
+	case *ast.StarExpr:
+		return p.freshPointer(e.X)
+
+	default:
+		return nil, "", false
+	}
+}
+
+// freshPointer checks if the pointer x is a fresh address, like `&T{}`, `new(T)` or `&(&T{}).Field`.
+func (p pass) freshPointer(x ast.Expr) (root types.Type, path string, ok bool) {
+	if root, path, ok := p.freshPath(x); ok {
+		return root, path, true
+	}
+
+	root, ok = p.isAddrOfCompLitOrNew(x)
+
+	return root, "", ok
+}
+
+// embedsPointer reports whether the field selection sel passes through an embedded pointer,
+// so that the field is not part of the receiver's allocation.
+func embedsPointer(sel *types.Selection) bool {
+	t := sel.Recv()
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	index := sel.Index()
+	for _, i := range index[:len(index)-1] {
+		s, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return true
+		}
+
+		t = s.Field(i).Type()
+		if _, ok := t.Underlying().(*types.Pointer); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -18,6 +18,7 @@ package analyzer
 
 import (
 	"flag"
+	"strconv"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
@@ -66,7 +67,8 @@ func New(opts ...Option) *analysis.Analyzer {
 		Flags: o.flags(),
 		Run:   o.run,
 
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Requires:  []*analysis.Analyzer{inspect.Analyzer},
+		FactTypes: []analysis.Fact{(*isFact)(nil), (*sentinelFact)(nil), (*genericFact)(nil)},
 	}
 }
 
@@ -76,6 +78,7 @@ func defaultOptions() *option {
 		name:    Name,
 		doc:     Doc,
 		checkis: true,
+		nolint:  true,
 	}
 }
 
@@ -92,5 +95,52 @@ func (o *option) flags() flag.FlagSet {
 	fs.BoolVar(&o.checkis, "check-is", o.checkis,
 		`suppress diagnostic on errors.Is if the compared type has an "Is(error) bool" method`)
 
+	fs.Var(optionalBool{&o.checkunwrap}, "check-unwrap",
+		`suppress diagnostic on errors.Is if the new error has an "Unwrap() error" or "Unwrap() []error" method (default check-is)`)
+
+	fs.BoolVar(&o.checkzerosized, "check-zero-sized", o.checkzerosized,
+		"report any comparison of pointers to zero-sized types, which is undefined")
+
+	fs.BoolVar(&o.checksentinels, "check-sentinels", o.checksentinels,
+		"report sentinel errors and returned errors pointing to new variables of zero-sized types")
+
+	fs.Var((*typesFlag)(&o.allowTypes), "allow-types",
+		`fully qualified types like "example.com/pkg.Type" whose comparisons are intentional (space-separated, repeatable)`)
+
+	fs.Var((*platformsFlag)(&o.platforms), "platforms",
+		`additional platforms "<goos>/<goarch>[,<tag>...]" to evaluate zero-sized types for, like "js/wasm" (space-separated, repeatable)`)
+
+	fs.BoolVar(&o.audit, "audit", o.audit, "report suppressed diagnostics with the reason")
+
+	fs.Var((*keyedFlag)(&o.keyed), "keyed",
+		`identity-keyed function "<function>:<index>[=<role>],..." like "(example.com/cache.Cache).Get:0=key" (space-separated, repeatable)`)
+
 	return fs
 }
+
+// optionalBool is a boolean [flag.Value] that distinguishes an unset flag from false.
+type optionalBool struct{ value **bool }
+
+// String implements [flag.Value].
+func (b optionalBool) String() string {
+	if b.value == nil || *b.value == nil {
+		return ""
+	}
+
+	return strconv.FormatBool(**b.value)
+}
+
+// Set implements [flag.Value].
+func (b optionalBool) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+
+	*b.value = &v
+
+	return nil
+}
+
+// IsBoolFlag allows the flag to be set without a value.
+func (optionalBool) IsBoolFlag() bool { return true }
@@ -18,10 +18,13 @@ package analyzer_test
 
 import (
 	"errors"
+	"slices"
 	"testing"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/analysistest"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
 
 	. "fillmore-labs.com/cmplint/analyzer"
 )
@@ -47,6 +50,77 @@ func TestAnalyzer(t *testing.T) {
 			options: WithCheckIs(false),
 			pkg:     "./b",
 		},
+		{
+			name:    "check-is=false, check-unwrap=true",
+			options: Join(WithCheckIs(false), WithCheckUnwrap(true)),
+			pkg:     "./h",
+		},
+		{
+			name: "check-unwrap via flags",
+			flags: map[string]string{
+				"check-is":     "false",
+				"check-unwrap": "true",
+			},
+			pkg: "./h",
+		},
+		{
+			name: "keyed functions",
+			options: WithKeyedFunctions(
+				"(test/c.cache).lookup:0=key",
+				"test/c.register:1=entry",
+			),
+			pkg: "./c",
+		},
+		{
+			name: "keyed functions via flags",
+			flags: map[string]string{
+				"keyed": "(*test/c.cache).lookup:0=key test/c.register:1=entry",
+			},
+			pkg: "./c",
+		},
+		{
+			name: "suppression policy",
+			options: Join(
+				WithCheckUnwrap(false),
+				WithAllowedTypes("test/d.symbol"),
+				WithAudit(true),
+			),
+			pkg: "./d",
+		},
+		{
+			name: "suppression policy via flags",
+			flags: map[string]string{
+				"check-unwrap": "false",
+				"allow-types":  "test/d.symbol",
+				"audit":        "true",
+			},
+			pkg: "./d",
+		},
+		{
+			name:    "nolint handled by the driver",
+			options: WithNolint(false),
+			pkg:     "./i",
+		},
+		{
+			name:    "check-sentinels",
+			options: WithCheckSentinels(true),
+			pkg:     "./f",
+		},
+		{
+			name:    "check-zero-sized",
+			options: WithCheckZeroSized(true),
+			pkg:     "./e",
+		},
+		{
+			name:    "platforms, check-zero-sized",
+			options: Join(WithPlatforms("js/wasm"), WithCheckZeroSized(true)),
+			pkg:     "./g",
+		},
+		{
+			name:    "platforms with load errors",
+			options: WithPlatforms("js/wasm"),
+			pkg:     "./j",
+		},
 		{
 			name: "check-is=false via flags",
 			options: Join(
@@ -71,7 +145,7 @@ func TestAnalyzer(t *testing.T) {
 				}
 			}
 
-			analysistest.Run(t, dir, a, tt.pkg)
+			analysistest.RunWithSuggestedFixes(t, dir, a, tt.pkg)
 		})
 	}
 }
@@ -83,3 +157,87 @@ func TestMissingInspector(t *testing.T) {
 		t.Errorf("Expected ErrNoInspector, got %v", err)
 	}
 }
+
+func TestGenericRelated(t *testing.T) {
+	t.Parallel()
+
+	results := analysistest.Run(t, analysistest.TestData(), New(), "./k")
+
+	var got []string
+
+	for _, r := range results {
+		for _, d := range r.Action.Diagnostics {
+			for _, rel := range d.Related {
+				got = append(got, rel.Message)
+			}
+		}
+	}
+
+	want := []string{
+		"IsNew instantiated with zero-sized T = empty",
+		"IsNew instantiated with zero-sized T = struct{}",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected related information %q, got %q", want, got)
+	}
+}
+
+func TestInvalidSetting(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		flag      string
+		flagValue string
+		option    Option
+		want      error
+	}{
+		{
+			name:      "keyed function",
+			flag:      "keyed",
+			flagValue: "(sync.Map).Load",
+			option:    WithKeyedFunctions("(sync.Map).Load:key"),
+			want:      ErrInvalidKeyedFunction,
+		},
+		{
+			name:      "allowed type",
+			flag:      "allow-types",
+			flagValue: "symbol",
+			option:    WithAllowedTypes("example.com/pkg."),
+			want:      ErrInvalidType,
+		},
+		{
+			name:      "platform",
+			flag:      "platforms",
+			flagValue: "wasm",
+			option:    WithPlatforms("js/"),
+			want:      ErrInvalidPlatform,
+		},
+		{
+			name:      "unknown platform",
+			flag:      "platforms",
+			flagValue: "linux/amd46",
+			option:    WithPlatforms("plan10/386"),
+			want:      ErrInvalidPlatform,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := New().Flags.Set(tt.flag, tt.flagValue); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+
+			pass := &analysis.Pass{
+				ResultOf: map[*analysis.Analyzer]any{inspect.Analyzer: inspector.New(nil)},
+			}
+
+			if _, err := New(tt.option).Run(pass); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
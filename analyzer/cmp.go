@@ -17,11 +17,17 @@
 package analyzer
 
 import (
+	"fmt"
 	"go/ast"
 	"go/format"
 	"go/token"
 	"go/types"
+	"strconv"
 	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/cmplint/internal/typeutil"
 )
 
 // comparison analyzes a comparison operation (either binary like `==` or
@@ -30,52 +36,259 @@ import (
 //
 // It reports a diagnostic if such a comparison is found, providing additional context
 // if the comparison involves zero-sized types.
-func (p pass) comparison(n ast.Node, left, right ast.Expr, isError bool) {
-	var (
-		t      types.Type // The type of T in a &T{} or new(T) operand
-		isLeft bool       // operand detected is on the left side of the comparison
-		other  ast.Expr   // isLeft ? right : left
-	)
+func (p pass) comparison(n ast.Node, left, right ast.Expr, isError bool, fixes ...analysis.SuggestedFix) {
+	t, other, isLeft, ok := p.freshOperand(left, right, p.isNewPointer)
+	if !ok {
+		if isError && p.incomparableTarget(n, left, right) {
+			return
+		}
+
+		if p.localComparison(n, left, right, isError) || p.loopComparison(n, left, right, isError) {
+			return
+		}
+
+		if p.checksentinels && p.sentinelComparison(n, left, right, isError) {
+			return
+		}
+
+		if p.checkzerosized {
+			p.zeroSizedComparison(n, left, right, isError)
+		}
 
-	// Determine if one of the operands is a new literal (&T{} or new(T)), check the left first.
-	if tl, ok := p.isAddrOfCompLitOrNew(left); ok {
-		t, other, isLeft = tl, right, true
-	} else if tr, ok := p.isAddrOfCompLitOrNew(right); ok {
-		t, other, isLeft = tr, left, false
-	} else {
 		return
 	}
 
-	// The `isLeft` flag is used by `shouldSuppressDiagnostic` to consider `Unwrap` methods
+	// The `isLeft` flag is used by `suppression` to consider `Unwrap` methods
 	// if the new literal is the first argument in an error comparison (`errors.Is(&T{}, target)`).
-	if t != nil && isError && p.checkis && shouldSuppressDiagnostic(t, isLeft) {
-		return
+	if t != nil && isError {
+		if reason, ok := p.suppression(t, other, isLeft); ok {
+			p.audit(n, t, reason)
+
+			return
+		}
 	}
 
 	// Determine if the comparison is with a zero-sized type and the other operand is not nil.
 	// In this case, the result is undefined.
 	isUndefined := false
 
-	if t != nil && IsZeroSized(t) {
+	if p.isZeroSized(t) {
 		otherType, ok := p.TypesInfo.Types[other]
 		isUndefined = !ok || !otherType.IsNil()
 	}
 
 	// Report diagnostic
-	typeName := "invalid type"
-	if t != nil {
-		typeName = types.TypeString(t, types.RelativeTo(p.Pkg))
+	fresh := right
+	if isLeft {
+		fresh = left
+	}
+
+	p.reportFresh(n, strconv.Quote(p.exprToString(other)), fresh, t, isUndefined, fixes...)
+}
+
+// zeroSizedComparison analyzes a comparison of pointers to zero-sized types, which may or may not be equal
+// even when they point to distinct variables. Comparisons with nil and of a variable with itself are defined.
+// For error comparisons, only targets without an `Is(error) bool` method are considered.
+func (p pass) zeroSizedComparison(n ast.Node, left, right ast.Expr, isError bool) {
+	left, right = p.unconvert(left), p.unconvert(right)
+
+	if p.isNil(left) || p.isNil(right) || p.exprToString(left) == p.exprToString(right) {
+		return
+	}
+
+	t, ok := p.zeroSizedElem(right)
+	if !ok && !isError {
+		t, ok = p.zeroSizedElem(left)
+	}
+
+	if !ok {
+		if _, found := p.platformFindings[p.key(n.Pos())]; found { // Zero-sized on other platforms only.
+			p.reportZeroSized(analysis.Diagnostic{Pos: n.Pos(), End: n.End()}, false)
+		}
+
+		return
+	}
+
+	if isError && types.Implements(types.NewPointer(t), errorIsInterface) || p.allowed(n, t) {
+		return
+	}
+
+	p.reportZeroSized(rangeDiagnostic(n,
+		"Result of comparison of %q with %q is undefined, pointers to zero-sized type %q may or may not be equal",
+		p.exprToString(left), p.exprToString(right), p.typeString(t)), true)
+}
+
+// zeroSizedElem returns the element type of x when it is a pointer to a zero-sized type.
+func (p pass) zeroSizedElem(x ast.Expr) (types.Type, bool) {
+	ptr, ok := p.TypesInfo.TypeOf(x).(*types.Pointer)
+	if !ok || !p.isZeroSized(ptr.Elem()) {
+		return nil, false
+	}
+
+	return ptr.Elem(), true
+}
+
+// isNil reports whether x is the predeclared nil.
+func (p pass) isNil(x ast.Expr) bool {
+	tv, ok := p.TypesInfo.Types[x]
+
+	return ok && tv.IsNil()
+}
+
+// markComparison analyzes an error comparison with mark semantics, like cockroachdb's `errors.Is`,
+// where errors also match when their types and messages are equal, even across a network boundary.
+// Comparisons with the address of a composite literal or a new() call get a softer diagnostic.
+func (p pass) markComparison(n ast.Node, left, right ast.Expr) {
+	t, other, _, ok := p.freshOperand(left, right, p.isAddrOfCompLitOrNew)
+	if !ok || p.allowed(n, t) {
+		return
+	}
+
+	p.ReportRangef(n,
+		"Comparison of %q with address of new variable of type %q relies on error mark equivalence (type and message)",
+		p.exprToString(other), p.typeString(t))
+}
+
+// freshOperand determines if one of the operands is a new pointer according to fresh, checking the left first.
+// It returns the element type of the new pointer, the other operand and whether the new pointer is on the left.
+func (p pass) freshOperand(left, right ast.Expr, fresh func(ast.Expr) (types.Type, bool)) (t types.Type, other ast.Expr, isLeft, ok bool) {
+	if tl, ok := fresh(left); ok {
+		return tl, right, true, true
+	}
+
+	if tr, ok := fresh(right); ok {
+		return tr, left, false, true
+	}
+
+	return nil, nil, false, false
+}
+
+// matcher analyzes the expected value of a matcher like gomega's `BeIdenticalTo(&T{})`,
+// which is compared later with the actual value passed separately.
+func (p pass) matcher(n ast.Node, expected ast.Expr, isError bool) {
+	t, ok := p.isAddrOfCompLitOrNew(expected)
+	if !ok {
+		return
+	}
+
+	if t != nil && isError {
+		if reason, ok := p.suppression(t, nil, false); ok {
+			p.audit(n, t, reason)
+
+			return
+		}
 	}
 
-	if otherStr := p.exprToString(other); isUndefined {
-		p.ReportRangef(n,
-			"Result of comparison of %q with address of new zero-sized variable of type %q is false or undefined",
-			otherStr, typeName)
+	p.reportFresh(n, "actual value", expected, t, p.isZeroSized(t))
+}
+
+// elementComparison analyzes a function like `slices.Contains(s, v)` that compares the
+// elements of a container with a value. It reports a diagnostic when the value is
+// the address of a composite literal or a new() call.
+func (p pass) elementComparison(n ast.Node, container, elem ast.Expr) {
+	t, ok := p.isAddrOfCompLitOrNew(elem)
+	if !ok {
+		return
+	}
+
+	p.reportFresh(n, "elements of "+strconv.Quote(p.exprToString(container)), elem, t, p.isZeroSized(t))
+}
+
+// containerComparison analyzes a function like `slices.Equal(s1, s2)` that compares the
+// elements of two containers. It reports a diagnostic when one container is a literal with
+// an element that is the address of a composite literal or a new() call.
+func (p pass) containerComparison(n ast.Node, left, right ast.Expr) {
+	for _, c := range [...]struct{ lit, other ast.Expr }{{left, right}, {right, left}} {
+		cl, ok := ast.Unparen(c.lit).(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+
+		for _, elt := range cl.Elts {
+			elems := []ast.Expr{elt}
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elems[0] = kv.Value
+				if _, isMap := p.TypesInfo.TypeOf(cl).Underlying().(*types.Map); isMap {
+					elems = append(elems, kv.Key)
+				}
+			}
+
+			for _, e := range elems {
+				if t, ok := p.isAddrOfCompLitOrNew(e); ok {
+					p.reportFresh(n, "elements of "+strconv.Quote(p.exprToString(c.other)), e, t, p.isZeroSized(t))
+
+					return // Report only the first offending element.
+				}
+			}
+		}
+	}
+}
+
+// reportFresh reports the comparison of subject with the address fresh of a new variable of type t.
+func (p pass) reportFresh(
+	n ast.Node, subject string, fresh ast.Expr, t types.Type, isUndefined bool, fixes ...analysis.SuggestedFix,
+) {
+	if p.allowed(n, t) {
+		return
+	}
+
+	var (
+		message string
+		related []analysis.RelatedInformation
+	)
+
+	if variable := p.freshVariable(fresh, t, isUndefined); isUndefined {
+		message = fmt.Sprintf(
+			"Result of comparison of %s with address of %s is false or undefined",
+			subject, variable)
+	} else if tparams, instances, ok := p.genericComparison(t); ok {
+		message = fmt.Sprintf(
+			"Result of comparison of %s with address of %s is false, or undefined if instantiated with zero-sized %s",
+			subject, variable, tparams)
+		related = instances
 	} else {
-		p.ReportRangef(n,
-			"Result of comparison of %q with address of new variable of type %q is always false",
-			otherStr, typeName)
+		message = fmt.Sprintf(
+			"Result of comparison of %s with address of %s is always false",
+			subject, variable)
 	}
+
+	p.reportZeroSized(analysis.Diagnostic{
+		Pos:            n.Pos(),
+		End:            n.End(),
+		Message:        message,
+		SuggestedFixes: fixes,
+		Related:        related,
+	}, isUndefined)
+}
+
+// swap analyzes the `old` argument of a compare-and-swap operation like
+// `atomic.Pointer[T].CompareAndSwap`. When it is the address of a composite literal
+// or a new() call, it can never equal the stored pointer and the swap never happens.
+func (p pass) swap(n ast.Node, old ast.Expr) {
+	t, ok := p.isAddrOfCompLitOrNew(old)
+	if !ok || p.allowed(n, t) {
+		return
+	}
+
+	if isUndefined := p.isZeroSized(t); isUndefined {
+		p.reportZeroSized(rangeDiagnostic(n,
+			"Result of comparison of stored value with address of %s is false or undefined, the swap may never happen",
+			p.freshVariable(old, t, isUndefined)), isUndefined)
+	} else {
+		p.reportZeroSized(rangeDiagnostic(n,
+			"Result of comparison of stored value with address of %s is always false, the swap will never happen",
+			p.freshVariable(old, t, isUndefined)), isUndefined)
+	}
+}
+
+// typeString returns the string representation of t relative to the current package.
+func (p pass) typeString(t types.Type) string {
+	if t == nil {
+		return "invalid type"
+	}
+
+	// Resolve aliases, so that messages name the same type regardless of how it is spelled.
+	return types.TypeString(types.Unalias(t), types.RelativeTo(p.Pkg))
 }
 
 // exprToString converts an AST expression to its string representation.
@@ -90,10 +303,11 @@ func (p pass) exprToString(e ast.Expr) string {
 
 // isAddrOfCompLitOrNew checks if the given AST expression `x` represents
 // the address of a composite literal (`&T{...}`) or a call to the built-in
-// `new()` function (`new(T)`).
+// `new()` function (`new(T)`), possibly wrapped in a handle (`weak.Make(&T{})`).
+// Conversions to pointer, interface or `unsafe.Pointer` types (`error(&T{})`) are unwrapped.
 // It returns the element type `T` of the resulting pointer.
 func (p pass) isAddrOfCompLitOrNew(x ast.Expr) (typ types.Type, ok bool) {
-	switch e := ast.Unparen(x).(type) {
+	switch e := p.unconvert(x).(type) {
 	case *ast.UnaryExpr:
 		if e.Op != token.AND {
 			return nil, false // not &...
@@ -101,6 +315,10 @@ func (p pass) isAddrOfCompLitOrNew(x ast.Expr) (typ types.Type, ok bool) {
 
 		cl, ok := ast.Unparen(e.X).(*ast.CompositeLit)
 		if !ok {
+			if _, _, ok := p.freshAccess(e.X); ok {
+				return p.TypesInfo.TypeOf(e.X), true // &(&T{}).Field, &[]T{x}[0], ...
+			}
+
 			return nil, false // not &...{}
 		}
 
@@ -109,19 +327,30 @@ func (p pass) isAddrOfCompLitOrNew(x ast.Expr) (typ types.Type, ok bool) {
 		return typ, true
 
 	case *ast.CallExpr:
-		if len(e.Args) != 1 {
-			return nil, false // some function
+		if funType := p.TypesInfo.Types[e.Fun]; !funType.IsBuiltin() {
+			return p.isHandleOfNew(e) // maybe weak.Make(&T{})
 		}
 
-		if funType := p.TypesInfo.Types[e.Fun]; !funType.IsBuiltin() {
-			return nil, false // no built-in
+		if len(e.Args) != 1 {
+			return nil, false // some builtin
 		}
 
 		if fun, ok := ast.Unparen(e.Fun).(*ast.Ident); !ok || fun.Name != "new" {
 			return nil, false // not new(...)
 		}
 
-		typ = p.TypesInfo.TypeOf(e.Args[0])
+		if tv := p.TypesInfo.Types[e.Args[0]]; tv.IsType() {
+			return tv.Type, true // new(T)
+		}
+
+		if _, ok := p.newExprArg(e); !ok {
+			return nil, false
+		}
+
+		// new(expr): Untyped constants have their default type, so use the result type of the call.
+		if ptr, ok := p.TypesInfo.TypeOf(e).(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
 
 		return typ, true
 
@@ -130,35 +359,49 @@ func (p pass) isAddrOfCompLitOrNew(x ast.Expr) (typ types.Type, ok bool) {
 	}
 }
 
-// shouldSuppressDiagnostic determines whether a diagnostic should be suppressed.
-// This is primarily relevant for `errors.Is` calls, where certain patterns involving
-// `Is` or `Unwrap` methods might make the comparison legitimate despite involving a new address.
-func shouldSuppressDiagnostic(t types.Type, isLeft bool) bool {
-	ptr := types.NewPointer(t) // ptr is *T, the type of the newly created literal
-
-	// The standard library `errors.Is(err, target)` function checks if `err` (or an error
-	// in its `Unwrap` tree) matches `target`. This matching can occur in several ways.
-	// For this linter, which flags `errors.Is(err, &T{})` (where `&T{}` is the `target`),
-	// we are concerned with two scenarios for suppression:
-
-	// 1. If `target` could be matched by an `Is(error) bool` method of `err`, assume it would
-	//    be the `Is(error) bool` method of `target` and suppress the diagnostic in this case.
-	if types.Implements(ptr, errorIsInterface) {
-		return true
+// isHandleOfNew checks if the given call expression creates a handle like
+// `weak.Make(&T{})` or `unique.Make(&T{})` from the address of a composite literal
+// or a new() call. Such handles compare equal only when their pointers do.
+// It returns the element type `T` of the wrapped pointer.
+func (p pass) isHandleOfNew(e *ast.CallExpr) (typ types.Type, ok bool) {
+	fun, methodExpr, ok := typeutil.FuncOf(p.TypesInfo, e.Fun)
+	if !ok || methodExpr {
+		return nil, false
 	}
 
-	// 2. `err.Unwrap()`: If `err` is the newly created literal (`isLeft` is true),
-	//    and its type `*T` implements an `Unwrap` method, `errors.Is` will traverse
-	//    the unwrapped errors. The comparison might then be valid against an unwrapped error.
-	//    Thus, we suppress the diagnostic in this case.
-	if isLeft &&
-		(types.Implements(ptr, errorUnwrapInterface) ||
-			types.Implements(ptr, errorUnwrapArrayInterface)) {
-		return true
+	if _, ok := handles[typeutil.NewFuncName(fun)]; !ok || len(e.Args) != 1 {
+		return nil, false
 	}
 
-	// We do not have dynamic runtime types, these heuristics rely on static type information
-	// and seem to work well in practice.
+	return p.isAddrOfCompLitOrNew(e.Args[0])
+}
+
+// unconvert strips parentheses and conversions that preserve pointer identity,
+// i.e. conversions to pointer, interface or `unsafe.Pointer` types.
+func (p pass) unconvert(x ast.Expr) ast.Expr {
+	for {
+		e, ok := ast.Unparen(x).(*ast.CallExpr)
+		if !ok || len(e.Args) != 1 {
+			return ast.Unparen(x)
+		}
+
+		tv, ok := p.TypesInfo.Types[e.Fun]
+		if !ok || !tv.IsType() {
+			return e // not a conversion
+		}
+
+		switch u := tv.Type.Underlying().(type) {
+		case *types.Pointer, *types.Interface:
+
+		case *types.Basic:
+			if u.Kind() != types.UnsafePointer {
+				return e
+			}
 
-	return false
+		default:
+			return e
+		}
+
+		x = e.Args[0]
+	}
 }
@@ -0,0 +1,276 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// directive is an inline suppression comment like `//cmplint:ignore reason`,
+// `//nolint:cmplint // reason` or `//lint:ignore cmplint reason`.
+type directive struct {
+	comment  *ast.Comment
+	form     string // normalized form, like "//cmplint:ignore"
+	reason   string
+	explicit bool // names the analyzer explicitly, so it is reported when unused
+	file     string
+	line     int       // line the directive applies to
+	end      token.Pos // end of the statement header starting on line
+	used     bool
+}
+
+// directives are the inline suppression comments of a package.
+type directives struct {
+	list []*directive
+}
+
+// collectDirectives parses the suppression comments in the files of the current package.
+// Directives naming the analyzer without a reason are reported and ignored.
+// `//nolint` comments are only honored when enabled with [WithNolint].
+func (p pass) collectDirectives() *directives {
+	ds := &directives{}
+
+	for _, f := range p.Files {
+		var list []*directive
+
+		for _, cg := range f.Comments {
+			for _, c := range cg.List {
+				form, reason, explicit, ok := parseDirective(p.Analyzer.Name, c.Text)
+				if !ok || !p.nolint && strings.HasPrefix(form, "//nolint") {
+					continue
+				}
+
+				if explicit && reason == "" {
+					p.Pass.ReportRangef(c, "%s directive requires a reason", form)
+
+					continue
+				}
+
+				pos := p.Fset.Position(c.Slash)
+				list = append(list, &directive{
+					comment:  c,
+					form:     form,
+					reason:   reason,
+					explicit: explicit,
+					file:     pos.Filename,
+					line:     pos.Line,
+				})
+			}
+		}
+
+		if len(list) > 0 {
+			p.attachDirectives(f, list)
+			ds.list = append(ds.list, list...)
+		}
+	}
+
+	return ds
+}
+
+// parseDirective parses a suppression comment for the analyzer name.
+// It returns the normalized form of the directive, the reason and whether it names the analyzer explicitly.
+func parseDirective(name, text string) (form, reason string, explicit, ok bool) {
+	switch {
+	case strings.HasPrefix(text, "//"+name+":ignore"):
+		rest := strings.TrimPrefix(text, "//"+name+":ignore")
+		if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+			return "", "", false, false
+		}
+
+		return "//" + name + ":ignore", strings.TrimSpace(rest), true, true
+
+	case strings.HasPrefix(text, "//nolint"):
+		rest := strings.TrimPrefix(text, "//nolint")
+
+		linters, comment, _ := strings.Cut(rest, "//")
+		reason = strings.TrimSpace(comment)
+
+		linters = strings.TrimSpace(linters)
+		if linters == "" {
+			return "//nolint", reason, false, true // All linters.
+		}
+
+		list, found := strings.CutPrefix(linters, ":")
+		if !found {
+			return "", "", false, false
+		}
+
+		names := strings.Split(list, ",")
+		switch {
+		case slices.Contains(names, name):
+			return "//nolint:" + name, reason, true, true
+
+		case slices.Contains(names, "all"):
+			return "//nolint:all", reason, false, true
+
+		default:
+			return "", "", false, false
+		}
+
+	case strings.HasPrefix(text, "//lint:ignore "):
+		checks, rest, _ := strings.Cut(strings.TrimPrefix(text, "//lint:ignore "), " ")
+		if !slices.Contains(strings.Split(checks, ","), name) {
+			return "", "", false, false
+		}
+
+		return "//lint:ignore " + name, strings.TrimSpace(rest), true, true
+
+	default:
+		return "", "", false, false
+	}
+}
+
+// attachDirectives determines the lines and statements the directives of a file apply to.
+// A trailing directive applies to its own line, a directive on a line by itself to the next line.
+// When a statement starts on that line, the directive applies to the whole statement,
+// or to the header of compound statements like `if` and `for`.
+func (p pass) attachDirectives(f *ast.File, list []*directive) {
+	lineOf := func(pos token.Pos) int { return p.Fset.Position(pos).Line }
+
+	trailing := make(map[*directive]bool)
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n.(type) {
+		case nil, *ast.CommentGroup, *ast.Comment:
+			return false
+		}
+
+		for _, d := range list {
+			if n.End() <= d.comment.Pos() && lineOf(n.End()) == d.line {
+				trailing[d] = true
+			}
+		}
+
+		return true
+	})
+
+	for _, d := range list {
+		if !trailing[d] {
+			d.line++ // The directive is on a line by itself.
+		}
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n.(type) {
+		case ast.Stmt, *ast.GenDecl:
+			for _, d := range list {
+				if lineOf(n.Pos()) == d.line {
+					d.end = max(d.end, headerEnd(n))
+				}
+			}
+		}
+
+		return true
+	})
+}
+
+// headerEnd returns the end of a statement, excluding the body of compound statements.
+func headerEnd(n ast.Node) token.Pos {
+	switch n := n.(type) {
+	case *ast.IfStmt:
+		return n.Body.Lbrace
+
+	case *ast.ForStmt:
+		return n.Body.Lbrace
+
+	case *ast.RangeStmt:
+		return n.Body.Lbrace
+
+	case *ast.SwitchStmt:
+		return n.Body.Lbrace
+
+	case *ast.TypeSwitchStmt:
+		return n.Body.Lbrace
+
+	case *ast.SelectStmt:
+		return n.Body.Lbrace
+
+	case *ast.BlockStmt:
+		return n.Lbrace
+
+	case *ast.LabeledStmt:
+		return headerEnd(n.Stmt)
+
+	default:
+		return n.End()
+	}
+}
+
+// suppressing returns the directive suppressing a diagnostic at pos, or nil.
+func (p pass) suppressing(pos token.Pos) *directive {
+	if p.directives == nil || len(p.directives.list) == 0 {
+		return nil
+	}
+
+	position := p.Fset.Position(pos)
+
+	for _, d := range p.directives.list {
+		if d.file != position.Filename {
+			continue
+		}
+
+		if position.Line == d.line || d.comment.Pos() < pos && pos < d.end {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// reportUnusedDirectives reports directives naming the analyzer that did not suppress any diagnostic.
+func (p pass) reportUnusedDirectives() {
+	for _, d := range p.directives.list {
+		if d.explicit && !d.used {
+			p.Pass.ReportRangef(d.comment, "Unused %s directive", d.form)
+		}
+	}
+}
+
+// Report reports a diagnostic, unless it is suppressed by a directive.
+func (p pass) Report(diag analysis.Diagnostic) {
+	if d := p.suppressing(diag.Pos); d != nil {
+		d.used = true
+
+		if p.auditing {
+			p.Pass.Report(analysis.Diagnostic{
+				Pos:     diag.Pos,
+				End:     diag.End,
+				Message: fmt.Sprintf("Suppressed by %s directive (%s): %s", d.form, d.reason, diag.Message),
+			})
+		}
+
+		return
+	}
+
+	p.Pass.Report(diag)
+}
+
+// ReportRangef reports a diagnostic for the range, unless it is suppressed by a directive.
+func (p pass) ReportRangef(rng analysis.Range, format string, args ...any) {
+	p.Report(rangeDiagnostic(rng, format, args...))
+}
+
+// rangeDiagnostic creates a diagnostic for the range.
+func rangeDiagnostic(rng analysis.Range, format string, args ...any) analysis.Diagnostic {
+	return analysis.Diagnostic{Pos: rng.Pos(), End: rng.End(), Message: fmt.Sprintf(format, args...)}
+}
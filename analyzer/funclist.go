@@ -26,9 +26,52 @@ const (
 	funcErr1
 	funcCmp0
 	funcCmp1
+	funcSwap0
+	funcSwap1
+	funcElem0
+	funcColl0
+	funcSame0
+	funcSame1
+	funcMatch0
+	funcMatchErr0
+	funcCheck0
+	funcCheck1
+	funcCmpOpt0
+	funcRecv0
 )
 
+// minArgs returns the minimum number of arguments of a function call with this funcType.
+func (f funcType) minArgs() int {
+	switch f { //nolint:exhaustive
+	case funcMatch0, funcMatchErr0, funcRecv0:
+		return 1
+
+	default:
+		return 2
+	}
+}
+
+// profile describes the semantics of an errors.Is variant.
+type profile int
+
+const (
+	// profileIdentity compares errors with `==` or their `Is(error) bool` methods, like the standard library.
+	profileIdentity profile = iota
+
+	// profileMarks additionally compares the error "marks", the type name and message, of errors.
+	profileMarks
+)
+
+// profiles lists the semantic profiles of error libraries by package path, the default is [profileIdentity].
+var profiles = map[string]profile{ //nolint:gochecknoglobals
+	"github.com/cockroachdb/errors":         profileMarks,
+	"github.com/cockroachdb/errors/markers": profileMarks,
+}
+
 // Since we have a lot of hardcoded libraries here, a check by signature might be a better heuristic.
+//
+// Assertions comparing deeply, like gomega's Equal and MatchError (which falls back to reflect.DeepEqual),
+// goconvey's ShouldEqual or matryer/is' Equal, are correct for new variables and are not listed.
 var functions = map[typeutil.FuncName]funcType{ //nolint:gochecknoglobals
 	{Path: "errors", Name: "Is"}:                                                               funcErr0,
 	{Path: "golang.org/x/exp/errors", Name: "Is"}:                                              funcErr0,
@@ -43,6 +86,25 @@ var functions = map[typeutil.FuncName]funcType{ //nolint:gochecknoglobals
 	{Path: "gotest.tools/v3/assert", Name: "Equal"}:                                            funcCmp1,
 	{Path: "gotest.tools/v3/assert", Name: "ErrorIs"}:                                          funcErr1,
 	{Path: "gotest.tools/v3/assert/cmp", Name: "Equal"}:                                        funcCmp0,
+	{Path: "gotest.tools/v3/assert/cmp", Name: "ErrorIs"}:                                      funcErr0,
+	{Path: "github.com/google/go-cmp/cmp", Name: "Equal"}:                                      funcCmpOpt0,
+	{Path: "github.com/google/go-cmp/cmp", Name: "Diff"}:                                       funcCmpOpt0,
+	{Path: "github.com/onsi/gomega", Name: "BeIdenticalTo"}:                                    funcMatch0,
+	{Path: "github.com/onsi/gomega", Name: "MatchErrorStrictly"}:                               funcMatchErr0,
+	{Path: "github.com/frankban/quicktest", Name: "Assert"}:                                    funcCheck1,
+	{Path: "github.com/frankban/quicktest", Name: "Check"}:                                     funcCheck1,
+	{Path: "github.com/frankban/quicktest", Receiver: "C", Name: "Assert"}:                     funcCheck0,
+	{Path: "github.com/frankban/quicktest", Receiver: "C", Name: "Check"}:                      funcCheck0,
+	{Path: "github.com/go-quicktest/qt", Name: "Equals"}:                                       funcCmp0,
+	{Path: "github.com/go-quicktest/qt", Name: "ErrorIs"}:                                      funcErr0,
+	{Path: "github.com/smartystreets/goconvey/convey", Name: "So"}:                             funcCheck0,
+	{Path: "github.com/smartystreets/goconvey/convey", Name: "SoMsg"}:                          funcCheck1,
+	{Path: "github.com/smartystreets/goconvey/convey", Receiver: "C", Name: "So"}:              funcCheck0,
+	{Path: "github.com/smartystreets/goconvey/convey", Receiver: "C", Name: "SoMsg"}:           funcCheck1,
+	{Path: "github.com/smarty/assertions", Name: "ShouldPointTo"}:                              funcCmp0,
+	{Path: "github.com/smarty/assertions", Name: "ShouldNotPointTo"}:                           funcCmp0,
+	{Path: "github.com/smartystreets/assertions", Name: "ShouldPointTo"}:                       funcCmp0,
+	{Path: "github.com/smartystreets/assertions", Name: "ShouldNotPointTo"}:                    funcCmp0,
 	{Path: "github.com/stretchr/testify/assert", Name: "ErrorIs"}:                              funcErr1,
 	{Path: "github.com/stretchr/testify/assert", Name: "ErrorIsf"}:                             funcErr1,
 	{Path: "github.com/stretchr/testify/assert", Name: "NotErrorIs"}:                           funcErr1,
@@ -59,4 +121,96 @@ var functions = map[typeutil.FuncName]funcType{ //nolint:gochecknoglobals
 	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "ErrorIsf"}:    funcErr0,
 	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotErrorIs"}:  funcErr0,
 	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotErrorIsf"}: funcErr0,
+	{Path: "github.com/stretchr/testify/assert", Name: "Same"}:                                 funcSame1,
+	{Path: "github.com/stretchr/testify/assert", Name: "Samef"}:                                funcSame1,
+	{Path: "github.com/stretchr/testify/assert", Name: "NotSame"}:                              funcSame1,
+	{Path: "github.com/stretchr/testify/assert", Name: "NotSamef"}:                             funcSame1,
+	{Path: "github.com/stretchr/testify/require", Name: "Same"}:                                funcSame1,
+	{Path: "github.com/stretchr/testify/require", Name: "Samef"}:                               funcSame1,
+	{Path: "github.com/stretchr/testify/require", Name: "NotSame"}:                             funcSame1,
+	{Path: "github.com/stretchr/testify/require", Name: "NotSamef"}:                            funcSame1,
+	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "Same"}:         funcSame0,
+	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "Samef"}:        funcSame0,
+	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "NotSame"}:      funcSame0,
+	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "NotSamef"}:     funcSame0,
+	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "Same"}:        funcSame0,
+	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "Samef"}:       funcSame0,
+	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotSame"}:     funcSame0,
+	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotSamef"}:    funcSame0,
+	{Path: "slices", Name: "Contains"}:                                                         funcElem0,
+	{Path: "slices", Name: "Index"}:                                                            funcElem0,
+	{Path: "slices", Name: "Equal"}:                                                            funcColl0,
+	{Path: "maps", Name: "Equal"}:                                                              funcColl0,
+	{Path: "golang.org/x/exp/slices", Name: "Contains"}:                                        funcElem0,
+	{Path: "golang.org/x/exp/slices", Name: "Index"}:                                           funcElem0,
+	{Path: "golang.org/x/exp/slices", Name: "Equal"}:                                           funcColl0,
+	{Path: "golang.org/x/exp/maps", Name: "Equal"}:                                             funcColl0,
+	{Path: "sync/atomic", Name: "CompareAndSwapPointer"}:                                       funcSwap1,
+	{Path: "sync/atomic", Receiver: "Pointer", Name: "CompareAndSwap"}:                         funcSwap0,
+	{Path: "sync/atomic", Receiver: "Value", Name: "CompareAndSwap"}:                           funcSwap0,
+	{Path: "reflect", Receiver: "Value", Name: "Equal"}:                                        funcRecv0,
+}
+
+// handles lists functions creating comparable handles of their single argument,
+// which compare equal only when their arguments are identical.
+var handles = map[typeutil.FuncName]struct{}{ //nolint:gochecknoglobals
+	{Path: "unique", Name: "Make"}: {},
+	{Path: "weak", Name: "Make"}:   {},
+}
+
+//nolint:gochecknoglobals
+var (
+	reflectNew     = typeutil.FuncName{Path: "reflect", Name: "New"}
+	reflectTypeOf  = typeutil.FuncName{Path: "reflect", Name: "TypeOf"}
+	reflectTypeFor = typeutil.FuncName{Path: "reflect", Name: "TypeFor"}
+	reflectElem    = typeutil.FuncName{Path: "reflect", Receiver: "Type", Name: "Elem"}
+)
+
+// reflectPassing lists reflection functions and methods passing on the pointer of their argument
+// or receiver, so that `reflect.ValueOf(&T{}).Pointer()` is a new pointer too.
+var reflectPassing = map[typeutil.FuncName]struct{}{ //nolint:gochecknoglobals
+	{Path: "reflect", Name: "ValueOf"}:                          {},
+	{Path: "reflect", Receiver: "Value", Name: "Interface"}:     {},
+	{Path: "reflect", Receiver: "Value", Name: "Pointer"}:       {},
+	{Path: "reflect", Receiver: "Value", Name: "UnsafePointer"}: {},
+}
+
+// keyedFunctions lists identity-keyed operations, where an argument is looked up by identity.
+// Additional functions, like internal caches, can be registered with [WithKeyedFunctions].
+var keyedFunctions = map[typeutil.FuncName][]keyedArg{ //nolint:gochecknoglobals
+	{Path: "sync", Receiver: "Map", Name: "Load"}:                                     {{0, "key"}},
+	{Path: "sync", Receiver: "Map", Name: "LoadAndDelete"}:                            {{0, "key"}},
+	{Path: "sync", Receiver: "Map", Name: "LoadOrStore"}:                              {{0, "key"}},
+	{Path: "sync", Receiver: "Map", Name: "Delete"}:                                   {{0, "key"}},
+	{Path: "sync", Receiver: "Map", Name: "CompareAndDelete"}:                         {{0, "key"}, {1, "old value"}},
+	{Path: "sync", Receiver: "Map", Name: "CompareAndSwap"}:                           {{0, "key"}, {1, "old value"}},
+	{Path: "container/list", Receiver: "List", Name: "Remove"}:                        {{0, "element"}},
+	{Path: "container/list", Receiver: "List", Name: "MoveToFront"}:                   {{0, "element"}},
+	{Path: "container/list", Receiver: "List", Name: "MoveToBack"}:                    {{0, "element"}},
+	{Path: "container/list", Receiver: "List", Name: "MoveBefore"}:                    {{0, "element"}, {1, "mark"}},
+	{Path: "container/list", Receiver: "List", Name: "MoveAfter"}:                     {{0, "element"}, {1, "mark"}},
+	{Path: "container/list", Receiver: "List", Name: "InsertBefore"}:                  {{1, "mark"}},
+	{Path: "container/list", Receiver: "List", Name: "InsertAfter"}:                   {{1, "mark"}},
+	{Path: "github.com/hashicorp/golang-lru", Receiver: "Cache", Name: "Get"}:         {{0, "key"}},
+	{Path: "github.com/hashicorp/golang-lru", Receiver: "Cache", Name: "Peek"}:        {{0, "key"}},
+	{Path: "github.com/hashicorp/golang-lru", Receiver: "Cache", Name: "Contains"}:    {{0, "key"}},
+	{Path: "github.com/hashicorp/golang-lru", Receiver: "Cache", Name: "Remove"}:      {{0, "key"}},
+	{Path: "github.com/hashicorp/golang-lru/v2", Receiver: "Cache", Name: "Get"}:      {{0, "key"}},
+	{Path: "github.com/hashicorp/golang-lru/v2", Receiver: "Cache", Name: "Peek"}:     {{0, "key"}},
+	{Path: "github.com/hashicorp/golang-lru/v2", Receiver: "Cache", Name: "Contains"}: {{0, "key"}},
+	{Path: "github.com/hashicorp/golang-lru/v2", Receiver: "Cache", Name: "Remove"}:   {{0, "key"}},
+}
+
+// checkers lists package-level checker values passed to assertions like `c.Assert(got, qt.Equals, want)`
+// and the semantics of their comparison.
+var checkers = map[typeutil.FuncName]funcType{ //nolint:gochecknoglobals
+	{Path: "github.com/frankban/quicktest", Name: "Equals"}:                      funcCmp0,
+	{Path: "github.com/frankban/quicktest", Name: "ErrorIs"}:                     funcErr0,
+	{Path: "github.com/smartystreets/goconvey/convey", Name: "ShouldPointTo"}:    funcCmp0,
+	{Path: "github.com/smartystreets/goconvey/convey", Name: "ShouldNotPointTo"}: funcCmp0,
+}
+
+// errorOptions lists go-cmp options that make errors compare with `errors.Is` semantics.
+var errorOptions = map[typeutil.FuncName]struct{}{ //nolint:gochecknoglobals
+	{Path: "github.com/google/go-cmp/cmp/cmpopts", Name: "EquateErrors"}: {},
 }
@@ -0,0 +1,216 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"maps"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// genericFact records the type parameters of a generic function that make comparisons with
+// new variables in its body undefined when instantiated with zero-sized types.
+type genericFact struct {
+	// TypeParams are the indices of the type parameters.
+	TypeParams []int
+}
+
+// AFact implements [analysis.Fact].
+func (*genericFact) AFact() {}
+
+// String implements [fmt.Stringer].
+func (f *genericFact) String() string {
+	return fmt.Sprintf("undefined with zero-sized type parameters %v", f.TypeParams)
+}
+
+// typeParamOwner is the generic function declaring a type parameter.
+type typeParamOwner struct {
+	fun   *types.Func
+	index int
+}
+
+// typeParamOwners maps the type parameters of the generic functions declared in the current package to their owners.
+func (p pass) typeParamOwners() map[*types.TypeParam]typeParamOwner {
+	owners := make(map[*types.TypeParam]typeParamOwner)
+
+	for _, f := range p.Files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Type.TypeParams == nil {
+				continue
+			}
+
+			fun, ok := p.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+
+			tparams := fun.Signature().TypeParams()
+			for i := range tparams.Len() {
+				owners[tparams.At(i)] = typeParamOwner{fun: fun, index: i}
+			}
+		}
+	}
+
+	return owners
+}
+
+// typeParamDeps returns the type parameters t depends on, when t is zero-sized if and only if these are.
+// It returns nil when t is not zero-sized independently of type parameters.
+func (z *zeroSizer) typeParamDeps(t types.Type) []*types.TypeParam {
+	var deps []*types.TypeParam
+
+	var possible func(t types.Type) bool
+
+	possible = func(t types.Type) bool {
+		if z.isZeroSized(t) {
+			return true
+		}
+
+		if tparam, ok := t.(*types.TypeParam); ok {
+			if !slices.Contains(deps, tparam) {
+				deps = append(deps, tparam)
+			}
+
+			return true
+		}
+
+		switch u := t.Underlying().(type) {
+		case *types.Array:
+			return possible(u.Elem())
+
+		case *types.Struct:
+			for field := range u.Fields() {
+				if !possible(field.Type()) {
+					return false
+				}
+			}
+
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	if !possible(t) {
+		return nil
+	}
+
+	return deps
+}
+
+// genericComparison determines whether a comparison with a new variable of type t is undefined
+// depending on the instantiation of type parameters. It returns the type parameter names and
+// the zero-sized instantiations in the current package as related information.
+func (p pass) genericComparison(t types.Type) (names string, related []analysis.RelatedInformation, ok bool) {
+	tparams := p.zeroSizer.typeParamDeps(t)
+	if len(tparams) == 0 {
+		return "", nil, false
+	}
+
+	list := make([]string, 0, len(tparams))
+
+	for _, tparam := range tparams {
+		list = append(list, tparam.Obj().Name())
+
+		owner, ok := p.owners[tparam]
+		if !ok {
+			continue // Type parameter of a generic type.
+		}
+
+		if deps := p.genericDeps[owner.fun]; !slices.Contains(deps, owner.index) {
+			p.genericDeps[owner.fun] = append(deps, owner.index)
+		}
+
+		related = append(related, p.zeroSizedInstances(owner)...)
+	}
+
+	slices.SortFunc(related, func(a, b analysis.RelatedInformation) int { return cmp.Compare(a.Pos, b.Pos) })
+
+	return strings.Join(list, " and "), related, true
+}
+
+// zeroSizedInstances returns the instantiations of a generic function in the current package
+// with a zero-sized type argument for the type parameter. Instantiations with type parameters of
+// other generic functions, like `IsNew[U]` in `func F[U any]()`, are not followed to the
+// instantiations of these functions.
+func (p pass) zeroSizedInstances(owner typeParamOwner) []analysis.RelatedInformation {
+	var related []analysis.RelatedInformation
+
+	for id, inst := range p.TypesInfo.Instances {
+		if p.TypesInfo.Uses[id] != owner.fun || owner.index >= inst.TypeArgs.Len() {
+			continue
+		}
+
+		if arg := inst.TypeArgs.At(owner.index); p.isZeroSized(arg) {
+			related = append(related, analysis.RelatedInformation{
+				Pos: id.Pos(),
+				End: id.End(),
+				Message: fmt.Sprintf("%s instantiated with zero-sized %s = %s",
+					owner.fun.Name(), owner.fun.Signature().TypeParams().At(owner.index).Obj().Name(), p.typeString(arg)),
+			})
+		}
+	}
+
+	return related
+}
+
+// exportGenericFacts exports the facts for generic functions with comparisons depending on type parameters.
+func (p pass) exportGenericFacts() {
+	for fun, deps := range p.genericDeps {
+		slices.Sort(deps)
+		p.ExportObjectFact(fun, &genericFact{TypeParams: deps})
+	}
+}
+
+// instantiations reports instantiations of generic functions from other packages with zero-sized
+// type arguments that make comparisons with new variables in their bodies undefined.
+func (p pass) instantiations() {
+	for _, id := range slices.SortedFunc(maps.Keys(p.TypesInfo.Instances), func(a, b *ast.Ident) int {
+		return cmp.Compare(a.Pos(), b.Pos())
+	}) {
+		fun, ok := p.TypesInfo.Uses[id].(*types.Func)
+		if !ok || fun.Pkg() == nil || fun.Pkg() == p.Pkg {
+			continue
+		}
+
+		var fact genericFact
+		if !p.ImportObjectFact(fun, &fact) {
+			continue
+		}
+
+		inst := p.TypesInfo.Instances[id]
+		for _, i := range fact.TypeParams {
+			if i >= inst.TypeArgs.Len() {
+				continue
+			}
+
+			if arg := inst.TypeArgs.At(i); p.isZeroSized(arg) {
+				p.ReportRangef(id,
+					"Instantiation of %s with zero-sized type %q for %s makes comparisons with new variables in it undefined",
+					fun.Name(), p.typeString(arg), fun.Signature().TypeParams().At(i).Obj().Name())
+			}
+		}
+	}
+}
@@ -17,8 +17,13 @@
 package analyzer
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
 
 	"fillmore-labs.com/cmplint/internal/typeutil"
 )
@@ -29,18 +34,19 @@ func (p pass) handleBinaryExpr(n *ast.BinaryExpr) {
 	switch n.Op { //nolint:exhaustive
 	case token.EQL, token.NEQ:
 		// Delegate to comparison for further analysis of the comparison.
-		p.comparison(n, n.X, n.Y, false)
+		p.comparison(n, n.X, n.Y, false, p.derefFix(n)...)
 	}
 }
 
 // handleCallExpr processes function calls by identifier, specifically looking
 // for `errors.Is` (from standard library or x/exp) and assertion functions
-// from `github.com/stretchr/testify` that perform error comparisons.
+// from `github.com/stretchr/testify` that perform error comparisons, as well
+// as identity-keyed operations like `(*sync.Map).Load`.
 //
 // It checks if the function is one of the targeted comparison functions
 // and delegates the analysis of its arguments to comparison.
 func (p pass) handleCallExpr(n *ast.CallExpr, functions map[typeutil.FuncName]funcType) {
-	if len(n.Args) < 2 { // Other function or multi-valued argument
+	if len(n.Args) == 0 { // Other function
 		return
 	}
 
@@ -52,11 +58,22 @@ func (p pass) handleCallExpr(n *ast.CallExpr, functions map[typeutil.FuncName]fu
 
 	funcName := typeutil.NewFuncName(fun)
 
+	if args, ok := p.keyed[funcName]; ok {
+		// Delegate analysis of identity-keyed operations like (*sync.Map).Load(...).
+		p.handleKeyedCall(n, funcName, methodExpr, args)
+
+		return
+	}
+
 	ftyp, ok := functions[funcName]
 	if !ok {
 		return
 	}
 
+	if len(n.Args) < ftyp.minArgs() { // Multi-valued argument
+		return
+	}
+
 	baseArg := 0
 	if methodExpr {
 		baseArg = 1
@@ -64,6 +81,13 @@ func (p pass) handleCallExpr(n *ast.CallExpr, functions map[typeutil.FuncName]fu
 
 	switch ftyp {
 	case funcErr0:
+		if profiles[funcName.Path] == profileMarks {
+			// Delegate analysis of cockroachdb's errors.Is(..., ...) to markComparison.
+			p.markComparison(n, n.Args[baseArg], n.Args[baseArg+1])
+
+			return
+		}
+
 		// Delegate analysis of errors.Is(..., ...) to comparison.
 		p.comparison(n, n.Args[baseArg], n.Args[baseArg+1], true)
 
@@ -79,7 +103,7 @@ func (p pass) handleCallExpr(n *ast.CallExpr, functions map[typeutil.FuncName]fu
 
 	case funcCmp0:
 		// Delegate analysis of cmp(..., ...) to comparison.
-		p.comparison(n, n.Args[baseArg], n.Args[baseArg+1], true)
+		p.comparison(n, n.Args[baseArg], n.Args[baseArg+1], false)
 
 	case funcCmp1:
 		if len(n.Args) < 3+baseArg { // should not happen
@@ -91,9 +115,176 @@ func (p pass) handleCallExpr(n *ast.CallExpr, functions map[typeutil.FuncName]fu
 		// Delegate analysis of assert.Equal(t, ..., ...) to comparison.
 		p.comparison(n, n.Args[baseArg+1], n.Args[baseArg+2], false)
 
+	case funcSame0:
+		// Delegate analysis of s.Same(..., ...) to comparison, suggesting s.Equal.
+		p.comparison(n, n.Args[baseArg], n.Args[baseArg+1], false, p.equalFix(n, funcName)...)
+
+	case funcSame1:
+		if len(n.Args) < 3+baseArg { // should not happen
+			p.LogErrorf(n, "Got only %d arguments for %s, expected at least %d", len(n.Args), funcName, 3+baseArg)
+
+			return
+		}
+
+		// Delegate analysis of assert.Same(t, ..., ...) to comparison, suggesting assert.Equal.
+		p.comparison(n, n.Args[baseArg+1], n.Args[baseArg+2], false, p.equalFix(n, funcName)...)
+
+	case funcMatch0:
+		// Delegate analysis of the expected value of BeIdenticalTo(...) to matcher.
+		p.matcher(n, n.Args[baseArg], false)
+
+	case funcMatchErr0:
+		// Delegate analysis of the expected value of MatchErrorStrictly(...) to matcher.
+		p.matcher(n, n.Args[baseArg], true)
+
+	case funcCheck0:
+		if len(n.Args) < 3+baseArg { // Missing expected value
+			return
+		}
+
+		// Delegate analysis of c.Assert(..., qt.Equals, ...) to checker.
+		p.checker(n, n.Args[baseArg], n.Args[baseArg+1], n.Args[baseArg+2])
+
+	case funcCheck1:
+		if len(n.Args) < 4+baseArg { // Missing expected value
+			return
+		}
+
+		// Delegate analysis of qt.Assert(t, ..., qt.Equals, ...) to checker.
+		p.checker(n, n.Args[baseArg+1], n.Args[baseArg+2], n.Args[baseArg+3])
+
+	case funcCmpOpt0:
+		// go-cmp compares deeply, unless errors are compared with errors.Is semantics.
+		left, right := n.Args[baseArg], n.Args[baseArg+1]
+		if p.isError(left) && p.isError(right) && p.hasErrorOption(n.Args[baseArg+2:]) {
+			p.comparison(n, left, right, true)
+		}
+
+	case funcElem0:
+		// Delegate analysis of slices.Contains(s, v) to elementComparison.
+		p.elementComparison(n, n.Args[baseArg], n.Args[baseArg+1])
+
+	case funcColl0:
+		// Delegate analysis of slices.Equal(s1, s2) to containerComparison.
+		p.containerComparison(n, n.Args[baseArg], n.Args[baseArg+1])
+
+	case funcSwap0:
+		// Delegate analysis of (*atomic.Pointer[T]).CompareAndSwap(old, ...) to swap.
+		p.swap(n, n.Args[baseArg])
+
+	case funcSwap1:
+		if len(n.Args) < 3+baseArg { // should not happen
+			p.LogErrorf(n, "Got only %d arguments for %s, expected at least %d", len(n.Args), funcName, 3+baseArg)
+
+			return
+		}
+
+		// Delegate analysis of atomic.CompareAndSwapPointer(addr, old, ...) to swap.
+		p.swap(n, n.Args[baseArg+1])
+
+	case funcRecv0:
+		recv := n.Args[0]
+		if !methodExpr {
+			sel, ok := ast.Unparen(n.Fun).(*ast.SelectorExpr)
+			if !ok { // should not happen
+				return
+			}
+
+			recv = sel.X
+		}
+
+		// Delegate analysis of v.Equal(...) to comparison, comparing the receiver with the argument.
+		p.comparison(n, recv, n.Args[baseArg], false)
+
 	case funcNone: // should not happen
 		p.LogErrorf(n, "Unconfigured function %s", funcName)
 
 		return
 	}
 }
+
+// equalFix suggests replacing a pointer identity assertion like `assert.Same` with
+// its deep equality counterpart `assert.Equal`.
+func (p pass) equalFix(n *ast.CallExpr, funcName typeutil.FuncName) []analysis.SuggestedFix {
+	var id *ast.Ident
+
+	switch fun := ast.Unparen(n.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+
+	case *ast.SelectorExpr:
+		id = fun.Sel
+
+	default:
+		return nil
+	}
+
+	name := strings.Replace(funcName.Name, "Same", "Equal", 1)
+
+	return []analysis.SuggestedFix{{
+		Message:   fmt.Sprintf("Use %s to compare values", name),
+		TextEdits: []analysis.TextEdit{{Pos: id.Pos(), End: id.End(), NewText: []byte(name)}},
+	}}
+}
+
+// checker analyzes assertions like `c.Assert(got, qt.Equals, want)` that take a checker value,
+// by looking up the checker in the checkers catalog and delegating to comparison accordingly.
+func (p pass) checker(n *ast.CallExpr, got, checker, want ast.Expr) {
+	var id *ast.Ident
+
+	switch c := ast.Unparen(checker).(type) {
+	case *ast.Ident:
+		id = c
+
+	case *ast.SelectorExpr:
+		id = c.Sel
+
+	default:
+		return
+	}
+
+	v, ok := p.TypesInfo.Uses[id].(*types.Var)
+	if !ok || v.Pkg() == nil || v.Parent() != v.Pkg().Scope() {
+		return // Not a package-level variable.
+	}
+
+	switch checkers[typeutil.FuncName{Path: v.Pkg().Path(), Name: v.Name()}] {
+	case funcCmp0:
+		p.comparison(n, got, want, false)
+
+	case funcErr0:
+		p.comparison(n, got, want, true)
+
+	default:
+	}
+}
+
+// isError reports whether the static type of x implements error.
+// `cmpopts.EquateErrors()` only applies when both values are errors.
+func (p pass) isError(x ast.Expr) bool {
+	t := p.TypesInfo.TypeOf(x)
+
+	return t != nil && types.Implements(t, errorType().Underlying().(*types.Interface))
+}
+
+// hasErrorOption checks whether the `cmp.Option` arguments of a go-cmp function contain
+// an option like `cmpopts.EquateErrors()`, possibly nested in `cmp.Options{...}`.
+func (p pass) hasErrorOption(opts []ast.Expr) bool {
+	for _, opt := range opts {
+		switch o := ast.Unparen(opt).(type) {
+		case *ast.CallExpr:
+			if fun, _, ok := typeutil.FuncOf(p.TypesInfo, o.Fun); ok {
+				if _, ok := errorOptions[typeutil.NewFuncName(fun)]; ok {
+					return true
+				}
+			}
+
+		case *ast.CompositeLit:
+			if p.hasErrorOption(o.Elts) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
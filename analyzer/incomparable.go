@@ -0,0 +1,97 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/cmplint/internal/typeutil"
+)
+
+// incomparableTarget analyzes an error comparison like `errors.Is(err, MyErr{Details: []string{"x"}})`,
+// where the target has a non-comparable type. `errors.Is` only compares comparable targets with `==`,
+// so the result is always false unless an `Is(error) bool` method matches the target.
+// Conversions to interface types like `error(MyErr{...})` are unwrapped.
+// It returns true when the target is an error of a non-comparable type.
+func (p pass) incomparableTarget(n ast.Node, err, target ast.Expr) bool {
+	t := p.TypesInfo.TypeOf(p.unconvert(target))
+	if t == nil || types.IsInterface(t) || types.Comparable(t) || !p.isError(p.unconvert(target)) {
+		return false
+	}
+
+	if reason, ok := p.targetSuppression(t, err, false); ok {
+		if p.auditing {
+			p.Pass.ReportRangef(n, "Suppressed comparison with non-comparable target of type %q: %s", p.typeString(t), reason)
+		}
+
+		return true
+	}
+
+	if p.allowed(n, t) {
+		return true
+	}
+
+	p.Report(analysis.Diagnostic{
+		Pos: n.Pos(),
+		End: n.End(),
+		Message: fmt.Sprintf("Result of comparison of %q with non-comparable target of type %q is always false",
+			p.exprToString(err), p.typeString(t)),
+		SuggestedFixes: p.asFix(n, target),
+	})
+
+	return true
+}
+
+// asFix suggests checking for the error type with `errors.As(err, new(T))` instead of
+// `errors.Is(err, T{...})`, when the target is a (converted) composite literal and the package has an `As` function.
+func (p pass) asFix(n ast.Node, target ast.Expr) []analysis.SuggestedFix {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return nil
+	}
+
+	cl, ok := p.unconvert(target).(*ast.CompositeLit)
+	if !ok || cl.Type == nil {
+		return nil
+	}
+
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Is" {
+		return nil
+	}
+
+	fun, methodExpr, ok := typeutil.FuncOf(p.TypesInfo, call.Fun)
+	if !ok || methodExpr || fun.Signature().Recv() != nil || fun.Pkg() == nil {
+		return nil
+	}
+
+	if _, ok := fun.Pkg().Scope().Lookup("As").(*types.Func); !ok {
+		return nil
+	}
+
+	return []analysis.SuggestedFix{{
+		Message: "Check for the error type with As",
+		TextEdits: []analysis.TextEdit{
+			{Pos: sel.Sel.Pos(), End: sel.Sel.End(), NewText: []byte("As")},
+			{Pos: target.Pos(), End: target.End(), NewText: []byte("new(" + p.exprToString(cl.Type) + ")")},
+		},
+	}}
+}
@@ -0,0 +1,465 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/cmplint/internal/typeutil"
+)
+
+// isFact summarizes for which targets an `Is(error) bool` method may return true.
+// It is exported for methods, so that packages using the error type can use it.
+type isFact struct {
+	// Any is true when the method may match arbitrary targets, for example because
+	// the target is passed to other functions.
+	Any bool
+
+	// Targets are the fully qualified types ("*example.com/pkg.MyError") the method
+	// matches by type assertion, type switch or `errors.As`. Generic types depending on the
+	// receiver's type parameters are recorded by their origin ("*example.com/pkg.G[...]").
+	Targets []string
+
+	// Compares is true when the method compares the target by identity (`target == ErrFoo`).
+	// This never matches new variables as target, but may match when the method's receiver is new.
+	Compares bool
+}
+
+// AFact implements [analysis.Fact].
+func (*isFact) AFact() {}
+
+// String implements [fmt.Stringer].
+func (f *isFact) String() string {
+	var s string
+
+	switch {
+	case f.Any:
+		return "matches any target"
+
+	case len(f.Targets) == 0:
+		s = "matches no target"
+
+	default:
+		s = "matches " + strings.Join(f.Targets, ", ")
+	}
+
+	if f.Compares {
+		s += ", compares identity"
+	}
+
+	return s
+}
+
+// matches reports whether the summarized method could return true for a target of type t.
+// A nil t or an interface type stands for an unknown target.
+func (f *isFact) matches(t types.Type) bool {
+	if f.Any {
+		return true
+	}
+
+	if t == nil || types.IsInterface(t) {
+		return len(f.Targets) > 0
+	}
+
+	if name, ok := genericTargetName(t); ok && slices.Contains(f.Targets, name) {
+		return true // Matches any instantiation.
+	}
+
+	return slices.Contains(f.Targets, types.TypeString(t, nil))
+}
+
+// genericTargetName returns the name of the origin of an instantiated generic type t or *t,
+// like "*example.com/pkg.G[...]" for `*G[int]`.
+func genericTargetName(t types.Type) (string, bool) {
+	var prefix string
+	if ptr, ok := t.(*types.Pointer); ok {
+		prefix, t = "*", ptr.Elem()
+	}
+
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.TypeArgs().Len() == 0 {
+		return "", false
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() != nil {
+		prefix += obj.Pkg().Path() + "."
+	}
+
+	return prefix + obj.Name() + "[...]", true
+}
+
+// hasTypeParams reports whether t mentions a type parameter.
+func hasTypeParams(t types.Type) bool {
+	switch t := types.Unalias(t).(type) {
+	case *types.TypeParam:
+		return true
+
+	case *types.Pointer:
+		return hasTypeParams(t.Elem())
+
+	case *types.Slice:
+		return hasTypeParams(t.Elem())
+
+	case *types.Array:
+		return hasTypeParams(t.Elem())
+
+	case *types.Map:
+		return hasTypeParams(t.Key()) || hasTypeParams(t.Elem())
+
+	case *types.Chan:
+		return hasTypeParams(t.Elem())
+
+	case *types.Named:
+		for arg := range t.TypeArgs().Types() {
+			if hasTypeParams(arg) {
+				return true
+			}
+		}
+
+		return false
+
+	default:
+		return false
+	}
+}
+
+// anyIsFact is used for `Is(error) bool` methods without a summary.
+var anyIsFact = &isFact{Any: true} //nolint:gochecknoglobals
+
+// exportIsFacts summarizes all `Is(error) bool` methods declared in the current package
+// and exports the summaries as facts.
+func (p pass) exportIsFacts(in *inspector.Inspector) {
+	for n := range in.PreorderSeq((*ast.FuncDecl)(nil)) {
+		decl, _ := n.(*ast.FuncDecl)
+		if decl.Recv == nil || decl.Body == nil || decl.Name.Name != isMethodName {
+			continue
+		}
+
+		fun, ok := p.TypesInfo.Defs[decl.Name].(*types.Func)
+		if !ok || !isErrorIsSignature(fun.Signature()) {
+			continue
+		}
+
+		fact := p.summarizeIs(decl, fun)
+		p.isFacts[fun] = fact
+		p.ExportObjectFact(fun, fact)
+	}
+}
+
+// isErrorIsSignature reports whether sig is the signature `Is(error) bool`.
+func isErrorIsSignature(sig *types.Signature) bool {
+	return types.Identical(
+		types.NewSignatureType(nil, nil, nil, sig.Params(), sig.Results(), sig.Variadic()),
+		errorIsInterface.Method(0).Signature())
+}
+
+// summarizeIs analyzes the body of an `Is(error) bool` method to determine the types of targets
+// it may return true for. Identity comparisons (`target == e`) never match new variables and
+// are only recorded, type assertions, type switches and `errors.As` calls record the target types,
+// every other use of the target is conservatively assumed to match anything.
+func (p pass) summarizeIs(decl *ast.FuncDecl, fun *types.Func) *isFact {
+	if returnsOnlyFalse(decl.Body) {
+		return &isFact{}
+	}
+
+	target := fun.Signature().Params().At(0)
+	if target.Name() == "" || target.Name() == "_" {
+		return &isFact{Any: true} // The result does not depend on the target.
+	}
+
+	s := isSummarizer{pass: p, target: target, fact: &isFact{}}
+	ast.Inspect(decl.Body, s.visit)
+
+	if s.fact.Any {
+		s.fact.Targets = nil
+	}
+
+	return s.fact
+}
+
+// isSummarizer walks the body of an `Is(error) bool` method, see [pass.summarizeIs].
+type isSummarizer struct {
+	pass
+	target *types.Var
+	fact   *isFact
+	stack  []ast.Node
+}
+
+// visit implements the [ast.Inspect] callback.
+func (s *isSummarizer) visit(n ast.Node) bool {
+	if n == nil {
+		s.stack = s.stack[:len(s.stack)-1]
+
+		return true
+	}
+
+	if id, ok := n.(*ast.Ident); ok && s.TypesInfo.Uses[id] == s.target {
+		s.use(id)
+	}
+
+	s.stack = append(s.stack, n)
+
+	return true
+}
+
+// use classifies a single use of the target parameter.
+func (s *isSummarizer) use(id *ast.Ident) {
+	var parent, grandparent ast.Node
+
+	for i := len(s.stack) - 1; i >= 0; i-- {
+		if _, ok := s.stack[i].(*ast.ParenExpr); ok {
+			continue
+		}
+
+		if parent == nil {
+			parent = s.stack[i]
+
+			continue
+		}
+
+		grandparent = s.stack[i]
+
+		break
+	}
+
+	switch e := parent.(type) {
+	case *ast.BinaryExpr:
+		if e.Op == token.EQL || e.Op == token.NEQ {
+			s.fact.Compares = true // Identity comparison.
+
+			return
+		}
+
+	case *ast.TypeAssertExpr:
+		if e.Type != nil { // target.(T)
+			s.addTarget(s.TypesInfo.TypeOf(e.Type))
+
+			return
+		}
+
+		if s.typeSwitch(grandparent, e) { // switch target.(type)
+			return
+		}
+
+	case *ast.CallExpr:
+		if s.errorsAs(e, id) {
+			return
+		}
+	}
+
+	s.fact.Any = true
+}
+
+// typeSwitch records the case types of `switch target.(type)`.
+func (s *isSummarizer) typeSwitch(n ast.Node, ta *ast.TypeAssertExpr) bool {
+	var sw *ast.TypeSwitchStmt
+
+	for i := len(s.stack) - 1; i >= 0 && sw == nil; i-- {
+		sw, _ = s.stack[i].(*ast.TypeSwitchStmt)
+	}
+
+	if sw == nil {
+		return false
+	}
+
+	switch a := sw.Assign.(type) {
+	case *ast.ExprStmt:
+		if ast.Unparen(a.X) != ta {
+			return false
+		}
+
+	case *ast.AssignStmt:
+		if n != a || len(a.Rhs) != 1 || ast.Unparen(a.Rhs[0]) != ta {
+			return false
+		}
+
+	default:
+		return false
+	}
+
+	for _, stmt := range sw.Body.List {
+		clause, _ := stmt.(*ast.CaseClause)
+		if clause.List == nil && !returnsOnlyFalse(clause) {
+			s.fact.Any = true // default clause
+		}
+
+		for _, typ := range clause.List {
+			if tv := s.TypesInfo.Types[typ]; !tv.IsNil() {
+				s.addTarget(tv.Type)
+			}
+		}
+	}
+
+	return true
+}
+
+// errorsAs records the type of `v` in `errors.As(target, &v)`.
+func (s *isSummarizer) errorsAs(call *ast.CallExpr, id *ast.Ident) bool {
+	if len(call.Args) != 2 || ast.Unparen(call.Args[0]) != id {
+		return false
+	}
+
+	fun, _, ok := typeutil.FuncOf(s.TypesInfo, call.Fun)
+	if !ok || typeutil.NewFuncName(fun) != (typeutil.FuncName{Path: "errors", Name: "As"}) {
+		return false
+	}
+
+	ptr, ok := s.TypesInfo.TypeOf(call.Args[1]).Underlying().(*types.Pointer)
+	if !ok {
+		return false
+	}
+
+	s.addTarget(ptr.Elem())
+
+	return true
+}
+
+// addTarget records a matched target type. Interface types could be implemented by anything.
+func (s *isSummarizer) addTarget(t types.Type) {
+	if t == nil || types.IsInterface(t) {
+		s.fact.Any = true
+
+		return
+	}
+
+	name := types.TypeString(t, nil)
+	if hasTypeParams(t) {
+		generic, ok := genericTargetName(t)
+		if !ok {
+			s.fact.Any = true // Like `target.([]P)`.
+
+			return
+		}
+
+		name = generic // Depends on the receiver's type parameters, like `*G[P]`.
+	}
+
+	if !slices.Contains(s.fact.Targets, name) {
+		s.fact.Targets = append(s.fact.Targets, name)
+	}
+}
+
+// returnsOnlyFalse reports whether all return statements in n return the constant false.
+func returnsOnlyFalse(n ast.Node) bool {
+	only := true
+
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+
+		case *ast.ReturnStmt:
+			if len(n.Results) != 1 {
+				only = false
+
+				break
+			}
+
+			if id, ok := ast.Unparen(n.Results[0]).(*ast.Ident); !ok || id.Name != "false" {
+				only = false
+			}
+		}
+
+		return only
+	})
+
+	return only
+}
+
+// isFactOf returns the summary of the `Is(error) bool` method of t, or nil when t has none.
+func (p pass) isFactOf(t types.Type) *isFact {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, isMethodName)
+
+	fun, ok := obj.(*types.Func)
+	if !ok || !isErrorIsSignature(fun.Signature()) {
+		return nil
+	}
+
+	fun = fun.Origin()
+
+	if fact, ok := p.isFacts[fun]; ok {
+		return fact
+	}
+
+	fact := new(isFact)
+	if fun.Pkg() != nil && fun.Pkg() != p.Pkg && p.ImportObjectFact(fun, fact) {
+		return fact
+	}
+
+	return anyIsFact // Interface methods or packages without facts.
+}
+
+// isAcceptors indexes the receiver types of all `Is(error) bool` methods in the analyzed
+// program by the fully qualified target types they match.
+func (p pass) isAcceptors() map[string][]types.Type {
+	acceptors := make(map[string][]types.Type)
+
+	for _, f := range p.AllObjectFacts() {
+		fact, ok := f.Fact.(*isFact)
+		if !ok {
+			continue
+		}
+
+		fun, ok := f.Object.(*types.Func)
+		if !ok || fun.Signature().Recv() == nil || fun.Signature().RecvTypeParams().Len() > 0 {
+			continue
+		}
+
+		recv := fun.Signature().Recv().Type()
+		for _, target := range fact.Targets {
+			acceptors[target] = append(acceptors[target], recv)
+		}
+	}
+
+	return acceptors
+}
+
+// couldBeAcceptor reports whether an error of the static type of err could dynamically be
+// of a type with an `Is(error) bool` method matching target and returns that type.
+// A nil err stands for an unknown error.
+func (p pass) couldBeAcceptor(target types.Type, err ast.Expr) (types.Type, bool) {
+	var errType types.Type
+	if err != nil {
+		errType = p.TypesInfo.TypeOf(err)
+	}
+
+	for _, recv := range p.acceptors[types.TypeString(target, nil)] {
+		dynamic := []types.Type{recv}
+		if _, ok := recv.(*types.Pointer); !ok {
+			dynamic = append(dynamic, types.NewPointer(recv)) // Value receivers are in both method sets.
+		}
+
+		for _, t := range dynamic {
+			if errType == nil || types.Identical(errType, t) {
+				return t, true
+			}
+
+			if iface, ok := errType.Underlying().(*types.Interface); ok && types.Implements(t, iface) {
+				return t, true
+			}
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"maps"
+	"strconv"
+	"strings"
+
+	"fillmore-labs.com/cmplint/internal/typeutil"
+)
+
+// keyedArg describes an argument of an identity-keyed operation, like the key of `(*sync.Map).Load`.
+// An address of a composite literal or a new() call passed here never matches.
+type keyedArg struct {
+	index int    // index of the argument, not counting the receiver
+	role  string // description of the argument ("key")
+}
+
+// defaultRole is used for custom identity-keyed functions without a role description.
+const defaultRole = "argument"
+
+// ErrInvalidKeyedFunction is returned for malformed identity-keyed function specifications.
+var ErrInvalidKeyedFunction = errors.New("invalid keyed function")
+
+// parseKeyedFunction parses an identity-keyed function specification of the form
+// "<function>:<index>[=<role>][,<index>[=<role>]...]", for example
+// "(github.com/hashicorp/golang-lru/v2.Cache).Get:0=key".
+func parseKeyedFunction(spec string) (typeutil.FuncName, []keyedArg, error) {
+	name, argspec, ok := cutLast(spec, ':')
+	if !ok {
+		return typeutil.FuncName{}, nil, fmt.Errorf("%w %q: missing argument index", ErrInvalidKeyedFunction, spec)
+	}
+
+	fun, err := typeutil.ParseFuncName(name)
+	if err != nil {
+		return typeutil.FuncName{}, nil, fmt.Errorf("%w %q: %w", ErrInvalidKeyedFunction, spec, err)
+	}
+
+	var args []keyedArg
+
+	for arg := range strings.SplitSeq(argspec, ",") {
+		idx, role, ok := strings.Cut(arg, "=")
+		if !ok || role == "" {
+			role = defaultRole
+		}
+
+		index, err := strconv.Atoi(idx)
+		if err != nil || index < 0 {
+			return typeutil.FuncName{}, nil, fmt.Errorf("%w %q: invalid argument index %q", ErrInvalidKeyedFunction, spec, idx)
+		}
+
+		args = append(args, keyedArg{index: index, role: role})
+	}
+
+	return fun, args, nil
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s string, sep byte) (before, after string, found bool) {
+	if i := strings.LastIndexByte(s, sep); i >= 0 {
+		return s[:i], s[i+1:], true
+	}
+
+	return s, "", false
+}
+
+// keyedFunctionsWith returns the built-in identity-keyed functions merged with the custom specifications.
+func keyedFunctionsWith(specs []string) (map[typeutil.FuncName][]keyedArg, error) {
+	if len(specs) == 0 {
+		return keyedFunctions, nil
+	}
+
+	keyed := maps.Clone(keyedFunctions)
+
+	for _, spec := range specs {
+		fun, args, err := parseKeyedFunction(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		keyed[fun] = args
+	}
+
+	return keyed, nil
+}
+
+// keyedFlag is a repeatable [flag.Value] collecting space-separated identity-keyed function specifications.
+type keyedFlag []string
+
+// String implements [flag.Value].
+func (f *keyedFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return strings.Join(*f, " ")
+}
+
+// Set implements [flag.Value].
+func (f *keyedFlag) Set(specs string) error {
+	for spec := range strings.FieldsSeq(specs) {
+		if _, _, err := parseKeyedFunction(spec); err != nil {
+			return err
+		}
+
+		*f = append(*f, spec)
+	}
+
+	return nil
+}
+
+// handleKeyedCall checks calls of identity-keyed operations like `(*sync.Map).Load(&k{})`,
+// where an argument is the address of a composite literal or a new() call.
+func (p pass) handleKeyedCall(n *ast.CallExpr, fun typeutil.FuncName, methodExpr bool, args []keyedArg) {
+	baseArg := 0
+	if methodExpr {
+		baseArg = 1
+	}
+
+	for _, arg := range args {
+		if baseArg+arg.index >= len(n.Args) {
+			continue // Variadic or multi-valued argument.
+		}
+
+		key := n.Args[baseArg+arg.index]
+
+		t, ok := p.isAddrOfCompLitOrNew(key)
+		if !ok || p.allowed(n, t) {
+			continue
+		}
+
+		isUndefined := p.isZeroSized(t)
+
+		if variable := p.freshVariable(key, t, isUndefined); isUndefined {
+			p.reportZeroSized(rangeDiagnostic(n,
+				"The %s of %s is the address of %s and may never match",
+				arg.role, fun, variable), isUndefined)
+		} else {
+			p.reportZeroSized(rangeDiagnostic(n,
+				"The %s of %s is the address of %s and will never match",
+				arg.role, fun, variable), isUndefined)
+		}
+	}
+}
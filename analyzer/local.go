@@ -0,0 +1,238 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// localVars summarizes how the address of local variables, parameters and receivers is used.
+type localVars struct {
+	addressed map[*types.Var]int         // Number of places where the address of a variable is taken.
+	loop      map[*types.Var]ast.Stmt    // Variables declared in the header of a for statement.
+	assigned  map[*types.Var][]token.Pos // Positions where a variable is assigned.
+	closures  []ast.Node                 // Function literals, which may assign captured variables.
+}
+
+// collectLocals counts the places where the address of a variable is taken, explicitly with `&x`, `&x.f`
+// or `x[:]`, or implicitly by calling a method with pointer receiver, like `x.M()`.
+func (p pass) collectLocals(in *inspector.Inspector) localVars {
+	locals := localVars{
+		addressed: make(map[*types.Var]int),
+		loop:      make(map[*types.Var]ast.Stmt),
+		assigned:  make(map[*types.Var][]token.Pos),
+	}
+
+	for n := range in.PreorderSeq(
+		(*ast.UnaryExpr)(nil), (*ast.SelectorExpr)(nil), (*ast.SliceExpr)(nil),
+		(*ast.RangeStmt)(nil), (*ast.ForStmt)(nil), (*ast.AssignStmt)(nil), (*ast.IncDecStmt)(nil),
+		(*ast.FuncLit)(nil),
+	) {
+		switch n := n.(type) {
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				locals.addAddressed(p.rootVar(n.X))
+			}
+
+		case *ast.SelectorExpr:
+			if sel, ok := p.TypesInfo.Selections[n]; ok && sel.Kind() == types.MethodVal && !sel.Indirect() {
+				if _, ptrRecv := sel.Obj().Type().(*types.Signature).Recv().Type().Underlying().(*types.Pointer); ptrRecv {
+					locals.addAddressed(p.rootVar(n.X))
+				}
+			}
+
+		case *ast.SliceExpr:
+			if _, ok := p.TypesInfo.TypeOf(n.X).Underlying().(*types.Array); ok {
+				locals.addAddressed(p.rootVar(n.X))
+			}
+
+		case *ast.RangeStmt:
+			switch n.Tok { //nolint:exhaustive
+			case token.DEFINE:
+				locals.addLoop(p, n, n.Key, n.Value)
+
+			case token.ASSIGN:
+				locals.addAssigned(p, n.Key, n.Value)
+			}
+
+		case *ast.ForStmt:
+			if init, ok := n.Init.(*ast.AssignStmt); ok && init.Tok == token.DEFINE {
+				locals.addLoop(p, n, init.Lhs...)
+			}
+
+		case *ast.AssignStmt:
+			locals.addAssigned(p, n.Lhs...)
+
+		case *ast.IncDecStmt:
+			locals.addAssigned(p, n.X)
+
+		case *ast.FuncLit:
+			locals.closures = append(locals.closures, n)
+		}
+	}
+
+	return locals
+}
+
+func (l localVars) addAddressed(v *types.Var) {
+	if v != nil {
+		l.addressed[v]++
+	}
+}
+
+func (l localVars) addLoop(p pass, loop ast.Stmt, exprs ...ast.Expr) {
+	for _, e := range exprs {
+		if id, ok := e.(*ast.Ident); ok {
+			if v, ok := p.TypesInfo.Defs[id].(*types.Var); ok {
+				l.loop[v] = loop
+			}
+		}
+	}
+}
+
+func (l localVars) addAssigned(p pass, exprs ...ast.Expr) {
+	for _, e := range exprs {
+		if e == nil {
+			continue
+		}
+
+		if id, ok := ast.Unparen(e).(*ast.Ident); ok {
+			if v, ok := p.TypesInfo.ObjectOf(id).(*types.Var); ok {
+				l.assigned[v] = append(l.assigned[v], id.Pos())
+			}
+		} else if v := p.rootVar(e); v != nil {
+			l.assigned[v] = append(l.assigned[v], e.Pos())
+		}
+	}
+}
+
+// rootVar returns the variable that holds x, stripping field selections and array indexing.
+func (p pass) rootVar(x ast.Expr) *types.Var {
+	for {
+		switch e := ast.Unparen(x).(type) {
+		case *ast.Ident:
+			v, _ := p.TypesInfo.Uses[e].(*types.Var)
+
+			return v
+
+		case *ast.SelectorExpr:
+			if sel, ok := p.TypesInfo.Selections[e]; !ok || sel.Kind() != types.FieldVal {
+				return nil
+			}
+
+			x = e.X
+
+		case *ast.IndexExpr:
+			if _, ok := p.TypesInfo.TypeOf(e.X).Underlying().(*types.Array); !ok {
+				return nil
+			}
+
+			x = e.X
+
+		default:
+			return nil
+		}
+	}
+}
+
+// freshLocal checks if x is the address `&v` of a parameter, receiver or local variable v
+// whose address is not taken anywhere else. Such a variable is a new copy for each call,
+// so no pointer from outside can point to it.
+func (p pass) freshLocal(x ast.Expr) (*types.Var, bool) {
+	u, ok := p.unconvert(x).(*ast.UnaryExpr)
+	if !ok || u.Op != token.AND {
+		return nil, false
+	}
+
+	id, ok := ast.Unparen(u.X).(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+
+	v, ok := p.TypesInfo.Uses[id].(*types.Var)
+	if !ok || p.locals.addressed[v] != 1 {
+		return nil, false
+	}
+
+	if _, ok := p.locals.loop[v]; ok {
+		return nil, false
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case types.ParamVar, types.RecvVar, types.LocalVar:
+		return v, true
+
+	default:
+		return nil, false
+	}
+}
+
+// localComparison analyzes a comparison where one operand is the address of a parameter,
+// receiver or local variable whose address is not taken elsewhere, like `target == &e` in
+// an `Is` method with value receiver. It returns true when such an operand is found.
+func (p pass) localComparison(n ast.Node, left, right ast.Expr, isError bool) bool {
+	for _, c := range [...]struct {
+		addr, other ast.Expr
+		isLeft      bool
+	}{{right, left, false}, {left, right, true}} {
+		v, ok := p.freshLocal(c.addr)
+		if !ok || p.isNil(p.unconvert(c.other)) {
+			continue
+		}
+
+		t := v.Type()
+		if isError {
+			if reason, ok := p.suppression(t, c.other, c.isLeft); ok {
+				p.audit(n, t, reason)
+
+				return true
+			}
+		}
+
+		if p.allowed(n, t) {
+			return true
+		}
+
+		kind, reason := "local variable", "its address is not taken elsewhere"
+		switch v.Kind() { //nolint:exhaustive
+		case types.ParamVar:
+			kind, reason = "parameter", "it points to a copy made for this call"
+
+		case types.RecvVar:
+			kind, reason = "receiver", "it points to a copy made for this call"
+		}
+
+		other := p.exprToString(c.other)
+		if isUndefined := p.isZeroSized(t); isUndefined {
+			p.reportZeroSized(rangeDiagnostic(n,
+				"Result of comparison of %q with address of zero-sized %s %q is false or undefined, %s",
+				other, kind, v.Name(), reason), isUndefined)
+		} else {
+			p.reportZeroSized(rangeDiagnostic(n,
+				"Result of comparison of %q with address of %s %q is always false, %s",
+				other, kind, v.Name(), reason), isUndefined)
+		}
+
+		return true
+	}
+
+	return false
+}
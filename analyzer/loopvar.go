@@ -0,0 +1,189 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"go/version"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// loopVarVersion is the first Go version where loop variables are created for each iteration.
+const loopVarVersion = "go1.22"
+
+// loopComparison analyzes a comparison where one operand is the address `&v` of a loop variable v
+// and the other one is a pointer variable defined outside the loop and not assigned in it.
+// Since each iteration has a new variable v, the pointer can't point to it.
+// It returns true when such a comparison is found.
+func (p pass) loopComparison(n ast.Node, left, right ast.Expr, isError bool) bool {
+	for _, c := range [...]struct {
+		addr, other ast.Expr
+		isLeft      bool
+	}{{right, left, false}, {left, right, true}} {
+		v, loop, ok := p.loopVar(c.addr)
+		if !ok || !p.unchangedIn(c.other, loop) {
+			continue
+		}
+
+		t := v.Type()
+		if isError {
+			if reason, ok := p.suppression(t, c.other, c.isLeft); ok {
+				p.audit(n, t, reason)
+
+				return true
+			}
+		}
+
+		if p.allowed(n, t) {
+			return true
+		}
+
+		other, isUndefined := p.exprToString(c.other), p.isZeroSized(t)
+
+		var d analysis.Diagnostic
+		if isUndefined {
+			d = rangeDiagnostic(n,
+				"Result of comparison of %q with address of zero-sized loop variable %q is false or undefined, it is a new variable in each iteration",
+				other, v.Name())
+		} else {
+			d = rangeDiagnostic(n,
+				"Result of comparison of %q with address of loop variable %q is always false, it is a new variable in each iteration",
+				other, v.Name())
+		}
+
+		d.SuggestedFixes = p.elementFix(c.addr, v, loop)
+		p.reportZeroSized(d, isUndefined)
+
+		return true
+	}
+
+	return false
+}
+
+// loopVar checks if x is the address `&v` of a per-iteration loop variable v.
+func (p pass) loopVar(x ast.Expr) (*types.Var, ast.Stmt, bool) {
+	u, ok := p.unconvert(x).(*ast.UnaryExpr)
+	if !ok || u.Op != token.AND {
+		return nil, nil, false
+	}
+
+	id, ok := ast.Unparen(u.X).(*ast.Ident)
+	if !ok {
+		return nil, nil, false
+	}
+
+	v, ok := p.TypesInfo.Uses[id].(*types.Var)
+	if !ok {
+		return nil, nil, false
+	}
+
+	loop, ok := p.locals.loop[v]
+	if !ok {
+		return nil, nil, false
+	}
+
+	if ver := p.fileVersion(loop.Pos()); ver == "" || version.Compare(ver, loopVarVersion) < 0 {
+		return nil, nil, false // Loop variables are shared between iterations.
+	}
+
+	return v, loop, true
+}
+
+// unchangedIn checks if x is a local pointer variable declared outside of loop, which is
+// neither assigned in the loop nor in a closure and whose address is not taken.
+func (p pass) unchangedIn(x ast.Expr, loop ast.Stmt) bool {
+	id, ok := p.unconvert(x).(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	v, ok := p.TypesInfo.Uses[id].(*types.Var)
+	if !ok || p.locals.addressed[v] != 0 || within(v.Pos(), loop) {
+		return false
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case types.ParamVar, types.RecvVar, types.LocalVar:
+	default:
+		return false
+	}
+
+	for _, pos := range p.locals.assigned[v] {
+		if within(pos, loop) {
+			return false
+		}
+
+		for _, closure := range p.locals.closures {
+			if within(pos, closure) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// elementFix suggests `&items[i]` instead of `&v` in a loop `for i, v := range items`,
+// when items is a slice or a pointer to an array.
+func (p pass) elementFix(addr ast.Expr, v *types.Var, loop ast.Stmt) []analysis.SuggestedFix {
+	r, ok := loop.(*ast.RangeStmt)
+	if !ok || r.Value == nil || p.TypesInfo.Defs[r.Value.(*ast.Ident)] != v {
+		return nil
+	}
+
+	key, ok := r.Key.(*ast.Ident)
+	if !ok || key.Name == "_" || p.locals.assigned[p.TypesInfo.Defs[key].(*types.Var)] != nil {
+		return nil
+	}
+
+	switch r.X.(type) {
+	case *ast.Ident, *ast.SelectorExpr:
+	default:
+		return nil // Might have side effects.
+	}
+
+	switch t := p.TypesInfo.TypeOf(r.X).Underlying().(type) {
+	case *types.Slice:
+
+	case *types.Pointer:
+		if _, ok := t.Elem().Underlying().(*types.Array); !ok {
+			return nil
+		}
+
+	default:
+		return nil
+	}
+
+	u := p.unconvert(addr)
+
+	return []analysis.SuggestedFix{{
+		Message: "Use the address of the element",
+		TextEdits: []analysis.TextEdit{{
+			Pos:     u.Pos(),
+			End:     u.End(),
+			NewText: []byte("&" + p.exprToString(r.X) + "[" + key.Name + "]"),
+		}},
+	}}
+}
+
+// within reports whether pos is inside of node n.
+func within(pos token.Pos, n ast.Node) bool {
+	return n.Pos() <= pos && pos < n.End()
+}
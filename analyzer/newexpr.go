@@ -0,0 +1,117 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"go/version"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// newExprVersion is the first Go version where `new` accepts an expression.
+const newExprVersion = "go1.26"
+
+// newExprArg returns the argument of a `new(expr)` call, where expr is a value instead of a type.
+// This form is only valid in files with Go version 1.26 or later.
+func (p pass) newExprArg(call *ast.CallExpr) (ast.Expr, bool) {
+	if fun, ok := ast.Unparen(call.Fun).(*ast.Ident); !ok || fun.Name != "new" || len(call.Args) != 1 {
+		return nil, false
+	}
+
+	if tv, ok := p.TypesInfo.Types[call.Args[0]]; !ok || tv.IsType() {
+		return nil, false
+	}
+
+	if funType := p.TypesInfo.Types[call.Fun]; !funType.IsBuiltin() {
+		return nil, false
+	}
+
+	if v := p.fileVersion(call.Pos()); v != "" && version.Compare(v, newExprVersion) < 0 {
+		return nil, false
+	}
+
+	return call.Args[0], true
+}
+
+// fileVersion returns the Go version of the file containing pos, or "" when unknown.
+func (p pass) fileVersion(pos token.Pos) string {
+	for _, f := range p.Files {
+		if f.FileStart <= pos && pos <= f.FileEnd {
+			return p.TypesInfo.FileVersions[f]
+		}
+	}
+
+	return ""
+}
+
+// derefFix suggests comparing values for a comparison with `new(expr)`, like `*p == expr` for `p == new(expr)`.
+func (p pass) derefFix(n *ast.BinaryExpr) []analysis.SuggestedFix {
+	for _, c := range [...]struct {
+		fresh, other ast.Expr
+		freshLeft    bool
+	}{{n.Y, n.X, false}, {n.X, n.Y, true}} {
+		call, ok := ast.Unparen(c.fresh).(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+
+		arg, ok := p.newExprArg(call)
+		if !ok {
+			continue
+		}
+
+		if _, ok := p.isAddrOfCompLitOrNew(c.other); ok {
+			return nil // both sides are fresh
+		}
+
+		freshType, otherType := p.TypesInfo.TypeOf(call), p.TypesInfo.TypeOf(c.other)
+		if freshType == nil || otherType == nil || !types.Identical(freshType, otherType) {
+			return nil
+		}
+
+		if !types.Comparable(freshType.(*types.Pointer).Elem()) {
+			return nil
+		}
+
+		value, deref := p.operand(arg), "*"+p.operand(c.other)
+
+		text := deref + " " + n.Op.String() + " " + value
+		if c.freshLeft {
+			text = value + " " + n.Op.String() + " " + deref
+		}
+
+		return []analysis.SuggestedFix{{
+			Message:   "Compare the values",
+			TextEdits: []analysis.TextEdit{{Pos: n.Pos(), End: n.End(), NewText: []byte(text)}},
+		}}
+	}
+
+	return nil
+}
+
+// operand formats x as an operand of a comparison, adding parentheses where needed.
+func (p pass) operand(x ast.Expr) string {
+	x = ast.Unparen(x)
+	if b, ok := x.(*ast.BinaryExpr); ok && b.Op.Precedence() <= token.EQL.Precedence() {
+		return "(" + p.exprToString(b) + ")"
+	}
+
+	return p.exprToString(x)
+}
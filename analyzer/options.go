@@ -101,3 +101,186 @@ func (o checkisOption) Apply(opts *option) {
 func (o checkisOption) LogAttr() slog.Attr {
 	return slog.Bool("check-is", o.checkis)
 }
+
+// WithCheckUnwrap returns an [Option] that configures the diagnostic suppression behavior
+// related to `Unwrap() error` and `Unwrap() []error` methods.
+// If `checkunwrap` is true, diagnostics for `errors.Is(&MyError{}, target)`
+// are suppressed if `*MyError` can be unwrapped.
+// If false, this specific suppression heuristic is disabled.
+// When not set, it follows [WithCheckIs].
+func WithCheckUnwrap(checkunwrap bool) Option {
+	return checkunwrapOption{checkunwrap: checkunwrap}
+}
+
+// checkunwrapOption implements the [Option] interface to configure the `checkunwrap` behavior.
+type checkunwrapOption struct {
+	checkunwrap bool
+}
+
+// Apply sets the checkunwrap field in the provided [options] struct.
+func (o checkunwrapOption) Apply(opts *option) {
+	opts.checkunwrap = &o.checkunwrap
+}
+
+// LogAttr implements [Option].
+func (o checkunwrapOption) LogAttr() slog.Attr {
+	return slog.Bool("check-unwrap", o.checkunwrap)
+}
+
+// WithCheckZeroSized returns an [Option] that enables reporting of all comparisons of pointers
+// to zero-sized types, not only those with the address of a new variable.
+// Pointers to distinct zero-sized variables may or may not be equal, so these comparisons are undefined.
+// Comparisons with nil and of a variable with itself are not reported. This check is disabled by default.
+func WithCheckZeroSized(checkzerosized bool) Option {
+	return checkzerosizedOption{checkzerosized: checkzerosized}
+}
+
+// checkzerosizedOption implements the [Option] interface to configure the zero-sized pointer check.
+type checkzerosizedOption struct {
+	checkzerosized bool
+}
+
+// Apply sets the checkzerosized field in the provided [options] struct.
+func (o checkzerosizedOption) Apply(opts *option) {
+	opts.checkzerosized = o.checkzerosized
+}
+
+// LogAttr implements [Option].
+func (o checkzerosizedOption) LogAttr() slog.Attr {
+	return slog.Bool("check-zero-sized", o.checkzerosized)
+}
+
+// WithCheckSentinels returns an [Option] that configures reporting of sentinel errors like
+// `var ErrSkip = &Skip{}` and returned errors pointing to new variables of zero-sized types,
+// as well as comparisons with such sentinels from other packages. This check is disabled by default.
+func WithCheckSentinels(checksentinels bool) Option {
+	return checksentinelsOption{checksentinels: checksentinels}
+}
+
+// checksentinelsOption implements the [Option] interface to configure the sentinel check.
+type checksentinelsOption struct {
+	checksentinels bool
+}
+
+// Apply sets the checksentinels field in the provided [options] struct.
+func (o checksentinelsOption) Apply(opts *option) {
+	opts.checksentinels = o.checksentinels
+}
+
+// LogAttr implements [Option].
+func (o checksentinelsOption) LogAttr() slog.Attr {
+	return slog.Bool("check-sentinels", o.checksentinels)
+}
+
+// WithAllowedTypes returns an [Option] that allows comparisons with new variables of the given
+// fully qualified types, like "example.com/pkg.Type", for example for types with interning
+// constructors or custom `Is` semantics.
+func WithAllowedTypes(names ...string) Option {
+	return allowTypesOption{names: names}
+}
+
+// allowTypesOption implements the [Option] interface to allow types.
+type allowTypesOption struct {
+	names []string
+}
+
+// Apply appends the type names to the allowTypes field in the provided [options] struct.
+func (o allowTypesOption) Apply(opts *option) {
+	opts.allowTypes = append(opts.allowTypes, o.names...)
+}
+
+// LogAttr implements [Option].
+func (o allowTypesOption) LogAttr() slog.Attr {
+	return slog.Any("allow-types", o.names)
+}
+
+// WithPlatforms returns an [Option] that additionally type-checks each package for the given platforms
+// of the form "<goos>/<goarch>[,<tag>...]", like "js/wasm" or "linux/arm64,purego". Comparisons involving
+// types that are zero-sized on any of these platforms are reported as undefined, listing the affected platforms.
+func WithPlatforms(specs ...string) Option {
+	return platformsOption{specs: specs}
+}
+
+// platformsOption implements the [Option] interface to configure additional platforms.
+type platformsOption struct {
+	specs []string
+}
+
+// Apply appends the specifications to the platforms field in the provided [options] struct.
+func (o platformsOption) Apply(opts *option) {
+	opts.platforms = append(opts.platforms, o.specs...)
+}
+
+// LogAttr implements [Option].
+func (o platformsOption) LogAttr() slog.Attr {
+	return slog.Any("platforms", o.specs)
+}
+
+// WithAudit returns an [Option] that reports suppressed diagnostics together with the reason
+// for the suppression, so reviewers can see what was silenced and why.
+func WithAudit(audit bool) Option {
+	return auditOption{audit: audit}
+}
+
+// auditOption implements the [Option] interface to configure the audit mode.
+type auditOption struct {
+	audit bool
+}
+
+// Apply sets the audit field in the provided [options] struct.
+func (o auditOption) Apply(opts *option) {
+	opts.audit = o.audit
+}
+
+// LogAttr implements [Option].
+func (o auditOption) LogAttr() slog.Attr {
+	return slog.Bool("audit", o.audit)
+}
+
+// WithNolint returns an [Option] that configures whether `//nolint` comments suppress diagnostics.
+// This is enabled by default and should be disabled by drivers like golangci-lint that handle
+// `//nolint` comments themselves.
+func WithNolint(nolint bool) Option {
+	return nolintOption{nolint: nolint}
+}
+
+// nolintOption implements the [Option] interface to configure the handling of `//nolint` comments.
+type nolintOption struct {
+	nolint bool
+}
+
+// Apply sets the nolint field in the provided [options] struct.
+func (o nolintOption) Apply(opts *option) {
+	opts.nolint = o.nolint
+}
+
+// LogAttr implements [Option].
+func (o nolintOption) LogAttr() slog.Attr {
+	return slog.Bool("nolint", o.nolint)
+}
+
+// WithKeyedFunctions returns an [Option] that registers additional identity-keyed functions,
+// like lookups in internal caches, in addition to the built-in catalog.
+//
+// Each specification has the form "<function>:<index>[=<role>][,<index>[=<role>]...]",
+// where <function> is "<path>.<name>" for functions or "(<path>.<receiver>).<name>" for methods,
+// and <index> is the zero-based argument index (not counting the receiver), for example
+// "(github.com/hashicorp/golang-lru/v2/expirable.LRU).Get:0=key".
+func WithKeyedFunctions(specs ...string) Option {
+	return keyedOption{specs: specs}
+}
+
+// keyedOption implements the [Option] interface to register identity-keyed functions.
+type keyedOption struct {
+	specs []string
+}
+
+// Apply appends the specifications to the keyed field in the provided [options] struct.
+func (o keyedOption) Apply(opts *option) {
+	opts.keyed = append(opts.keyed, o.specs...)
+}
+
+// LogAttr implements [Option].
+func (o keyedOption) LogAttr() slog.Attr {
+	return slog.Any("keyed", o.specs)
+}
@@ -0,0 +1,337 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/build"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/packages"
+)
+
+// ErrInvalidPlatform is returned for malformed platform specifications.
+var ErrInvalidPlatform = errors.New("invalid platform")
+
+// ErrPlatformLoad is reported when a package cannot be loaded for a platform.
+var ErrPlatformLoad = errors.New("can't load package for platform")
+
+// knownPlatforms are the supported "<goos>/<goarch>" combinations, as listed by `go tool dist list`.
+var knownPlatforms = map[string]struct{}{ //nolint:gochecknoglobals
+	"aix/ppc64": {}, "android/386": {}, "android/amd64": {}, "android/arm": {}, "android/arm64": {},
+	"darwin/amd64": {}, "darwin/arm64": {}, "dragonfly/amd64": {},
+	"freebsd/386": {}, "freebsd/amd64": {}, "freebsd/arm": {}, "freebsd/arm64": {},
+	"illumos/amd64": {}, "ios/amd64": {}, "ios/arm64": {}, "js/wasm": {},
+	"linux/386": {}, "linux/amd64": {}, "linux/arm": {}, "linux/arm64": {}, "linux/loong64": {},
+	"linux/mips": {}, "linux/mips64": {}, "linux/mips64le": {}, "linux/mipsle": {},
+	"linux/ppc64": {}, "linux/ppc64le": {}, "linux/riscv64": {}, "linux/s390x": {},
+	"netbsd/386": {}, "netbsd/amd64": {}, "netbsd/arm": {}, "netbsd/arm64": {},
+	"openbsd/386": {}, "openbsd/amd64": {}, "openbsd/arm": {}, "openbsd/arm64": {},
+	"openbsd/ppc64": {}, "openbsd/riscv64": {},
+	"plan9/386": {}, "plan9/amd64": {}, "plan9/arm": {},
+	"solaris/amd64": {}, "wasip1/wasm": {}, "windows/386": {}, "windows/amd64": {}, "windows/arm64": {},
+}
+
+// platform is a build configuration the package is additionally type-checked with,
+// to find types that are zero-sized only on some targets.
+type platform struct {
+	spec   string // "<goos>/<goarch>[,<tag>...]"
+	goos   string
+	goarch string
+	tags   []string
+}
+
+// parsePlatform parses a platform specification of the form "<goos>/<goarch>[,<tag>...]",
+// for example "linux/arm64" or "js/wasm,purego".
+func parsePlatform(spec string) (platform, error) {
+	target, tags, _ := strings.Cut(spec, ",")
+
+	goos, goarch, ok := strings.Cut(target, "/")
+	if !ok || goos == "" || goarch == "" {
+		return platform{}, fmt.Errorf("%w %q: expected \"<goos>/<goarch>[,<tag>...]\"", ErrInvalidPlatform, spec)
+	}
+
+	if _, ok := knownPlatforms[target]; !ok {
+		return platform{}, fmt.Errorf("%w %q: unknown \"<goos>/<goarch>\", see \"go tool dist list\"", ErrInvalidPlatform, spec)
+	}
+
+	p := platform{spec: spec, goos: goos, goarch: goarch}
+	if tags != "" {
+		p.tags = strings.Split(tags, ",")
+	}
+
+	return p, nil
+}
+
+// platformsWith parses the platform specifications.
+func platformsWith(specs []string) ([]platform, error) {
+	platforms := make([]platform, 0, len(specs))
+
+	for _, spec := range specs {
+		p, err := parsePlatform(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		platforms = append(platforms, p)
+	}
+
+	return platforms, nil
+}
+
+// platformsFlag is a repeatable [flag.Value] collecting space-separated platform specifications.
+type platformsFlag []string
+
+// String implements [flag.Value].
+func (f *platformsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return strings.Join(*f, " ")
+}
+
+// Set implements [flag.Value].
+func (f *platformsFlag) Set(specs string) error {
+	for spec := range strings.FieldsSeq(specs) {
+		if _, err := parsePlatform(spec); err != nil {
+			return err
+		}
+
+		*f = append(*f, spec)
+	}
+
+	return nil
+}
+
+// platformKey identifies a diagnostic position independently of the [token.FileSet].
+type platformKey struct {
+	file   string
+	offset int
+}
+
+// platformFinding is a diagnostic that involves a zero-sized type on other platforms.
+type platformFinding struct {
+	message   string   // the message of the zero-sized variant
+	platforms []string // the affected platforms
+}
+
+// hostPlatform returns the platform the package has been type-checked for.
+func hostPlatform() string {
+	return build.Default.GOOS + "/" + build.Default.GOARCH
+}
+
+// key returns the platform-independent key of pos.
+func (p pass) key(pos token.Pos) platformKey {
+	position := p.Fset.Position(pos)
+
+	return platformKey{file: position.Filename, offset: position.Offset}
+}
+
+// reportZeroSized reports a diagnostic that has a different message when a zero-sized type is involved.
+// In multi-platform mode, the platforms where the type is zero-sized are added to the message.
+func (p pass) reportZeroSized(d analysis.Diagnostic, undefined bool) {
+	switch {
+	case p.zeroSizedRecord != nil: // Analyzing for another platform.
+		if undefined {
+			p.zeroSizedRecord[p.key(d.Pos)] = d.Message
+		}
+
+		return
+
+	case p.platformFindings != nil:
+		var affected []string
+		if undefined {
+			affected = append(affected, hostPlatform())
+		}
+
+		if f, ok := p.platformFindings[p.key(d.Pos)]; ok {
+			if !undefined {
+				d.Message = f.message
+			}
+
+			affected = append(affected, f.platforms...)
+		}
+
+		if len(affected) > 0 {
+			d.Message += " (zero-sized on " + strings.Join(affected, ", ") + ")"
+		}
+	}
+
+	p.Report(d)
+}
+
+// platformFindings type-checks the package of the pass for the configured platforms and collects
+// the diagnostics involving zero-sized types, merged by position.
+// Only packages of the module under development are considered. Platforms the package is not built
+// for are skipped, load errors are reported at the package clause.
+func (o *option) platformFindings(a *analysis.Pass, platforms []platform) (map[platformKey]*platformFinding, error) {
+	findings := make(map[platformKey]*platformFinding)
+
+	if len(a.Files) == 0 {
+		return findings, nil
+	}
+
+	dir := filepath.Dir(a.Fset.File(a.Files[0].Pos()).Name())
+	if !rootPackage(a, dir) {
+		return findings, nil
+	}
+
+	for _, pl := range platforms {
+		pkg, err := loadPlatform(a, dir, pl)
+		if err != nil {
+			a.ReportRangef(a.Files[0].Name, "%v", err)
+
+			continue
+		}
+
+		if pkg == nil {
+			continue // The package is not built for this platform.
+		}
+
+		record, err := o.analyzePlatform(a, pkg)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, message := range record {
+			f, ok := findings[key]
+			if !ok {
+				f = &platformFinding{message: message}
+				findings[key] = f
+			}
+
+			f.platforms = append(f.platforms, pl.spec)
+		}
+	}
+
+	return findings, nil
+}
+
+// rootPackage reports whether the package of the pass in dir belongs to the module under development,
+// excluding the standard library and dependencies, which are analyzed for their facts only,
+// and generated test main packages.
+func rootPackage(a *analysis.Pass, dir string) bool {
+	if strings.HasSuffix(a.Pkg.Path(), ".test") {
+		return false // Generated test main package.
+	}
+
+	if a.Module != nil {
+		return a.Module.Path != "" && a.Module.Version == ""
+	}
+
+	// Some drivers don't provide module information.
+	rel, err := filepath.Rel(filepath.Join(build.Default.GOROOT, "src"), dir)
+
+	return err != nil || !filepath.IsLocal(rel)
+}
+
+// hasTestFiles reports whether one of the files is a test file.
+func hasTestFiles(files []string) bool {
+	return slices.ContainsFunc(files, func(file string) bool { return strings.HasSuffix(file, "_test.go") })
+}
+
+// analyzePlatform analyzes the package loaded for another platform, recording the messages
+// of diagnostics involving zero-sized types.
+func (o *option) analyzePlatform(a *analysis.Pass, pkg *packages.Package) (map[platformKey]string, error) {
+	other := &analysis.Pass{
+		Analyzer:          a.Analyzer,
+		Fset:              pkg.Fset,
+		Files:             pkg.Syntax,
+		Pkg:               pkg.Types,
+		TypesInfo:         pkg.TypesInfo,
+		TypesSizes:        pkg.TypesSizes,
+		ResultOf:          map[*analysis.Analyzer]any{inspect.Analyzer: inspector.New(pkg.Syntax)},
+		Report:            func(analysis.Diagnostic) {},
+		ImportObjectFact:  func(types.Object, analysis.Fact) bool { return false },
+		ExportObjectFact:  func(types.Object, analysis.Fact) {},
+		AllObjectFacts:    func() []analysis.ObjectFact { return nil },
+		ImportPackageFact: func(*types.Package, analysis.Fact) bool { return false },
+		ExportPackageFact: func(analysis.Fact) {},
+		AllPackageFacts:   func() []analysis.PackageFact { return nil },
+	}
+
+	p, err := o.newPass(other)
+	if err != nil {
+		return nil, err
+	}
+
+	p.zeroSizedRecord = make(map[platformKey]string)
+	p.analyze(other.ResultOf[inspect.Analyzer].(*inspector.Inspector))
+
+	return p.zeroSizedRecord, nil
+}
+
+// loadPlatform loads the variant of the package in dir matching the pass for a platform,
+// like "pkg", "pkg [pkg.test]" or "pkg_test [pkg.test]". It returns nil when the package
+// is not built for the platform, because build constraints exclude all of its files.
+//
+// Dependencies, including the standard library, are parsed and type-checked from source, since
+// export data of other toolchain versions may be unreadable.
+func loadPlatform(a *analysis.Pass, dir string, pl platform) (*packages.Package, error) {
+	files := make([]string, 0, len(a.Files))
+	for _, f := range a.Files {
+		files = append(files, a.Fset.File(f.Pos()).Name())
+	}
+
+	tests := hasTestFiles(files)
+
+	cfg := &packages.Config{
+		Mode:  packages.LoadAllSyntax,
+		Dir:   dir,
+		Env:   append(os.Environ(), "GOOS="+pl.goos, "GOARCH="+pl.goarch),
+		Tests: tests,
+	}
+
+	if len(pl.tags) > 0 {
+		cfg.BuildFlags = []string{"-tags=" + strings.Join(pl.tags, ",")}
+	}
+
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrPlatformLoad, pl.spec, err)
+	}
+
+	i := slices.IndexFunc(pkgs, func(pkg *packages.Package) bool {
+		return pkg.PkgPath == a.Pkg.Path() && hasTestFiles(pkg.GoFiles) == tests
+	})
+	if i < 0 || len(pkgs[i].GoFiles) == 0 {
+		return nil, nil
+	}
+
+	var errs []error
+	packages.Visit(pkgs[i:i+1], nil, func(pkg *packages.Package) {
+		for _, err := range pkg.Errors {
+			errs = append(errs, err)
+		}
+	})
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%w %q: %w", ErrPlatformLoad, pl.spec, errs[0])
+	}
+
+	return pkgs[i], nil
+}
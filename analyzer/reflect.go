@@ -0,0 +1,139 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"fillmore-labs.com/cmplint/internal/typeutil"
+)
+
+// isNewPointer checks like [pass.isAddrOfCompLitOrNew] if x is a new pointer, including pointers
+// created through reflection. Reflection is only considered for direct comparisons.
+func (p pass) isNewPointer(x ast.Expr) (typ types.Type, ok bool) {
+	if typ, ok := p.isAddrOfCompLitOrNew(x); ok {
+		return typ, true
+	}
+
+	return p.reflectFresh(x)
+}
+
+// reflectFresh checks if x yields a new pointer through reflection: `reflect.New(t)` with a
+// statically known type t, or a value passing on a new pointer, like `reflect.ValueOf(&T{})`,
+// `reflect.New(t).Interface()` or `reflect.ValueOf(new(T)).Pointer()`.
+// It returns the element type `T` of the pointer.
+func (p pass) reflectFresh(x ast.Expr) (typ types.Type, ok bool) {
+	e, ok := p.unconvert(x).(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+
+	f, methodExpr, ok := typeutil.FuncOf(p.TypesInfo, e.Fun)
+	if !ok || methodExpr {
+		return nil, false
+	}
+
+	fun := typeutil.NewFuncName(f)
+	if fun == reflectNew {
+		if len(e.Args) != 1 {
+			return nil, false
+		}
+
+		return p.reflectType(e.Args[0])
+	}
+
+	if _, ok := reflectPassing[fun]; !ok {
+		return nil, false
+	}
+
+	if fun.Receiver == "" { // reflect.ValueOf(&T{})
+		if len(e.Args) != 1 {
+			return nil, false
+		}
+
+		return p.isNewPointer(e.Args[0])
+	}
+
+	sel, ok := ast.Unparen(e.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+
+	return p.isNewPointer(sel.X) // reflect.New(t).Interface()
+}
+
+// reflectType determines the type described by the [reflect.Type] expression x,
+// like `reflect.TypeFor[T]()`, `reflect.TypeOf(T{})` or `reflect.TypeOf((*T)(nil)).Elem()`.
+func (p pass) reflectType(x ast.Expr) (types.Type, bool) {
+	call, ok := ast.Unparen(x).(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+
+	fun, methodExpr, ok := typeutil.FuncOf(p.TypesInfo, call.Fun)
+	if !ok || methodExpr {
+		return nil, false
+	}
+
+	switch typeutil.NewFuncName(fun) {
+	case reflectTypeOf:
+		if len(call.Args) != 1 {
+			return nil, false
+		}
+
+		t := p.TypesInfo.TypeOf(call.Args[0])
+		if t == nil || types.IsInterface(t) {
+			return nil, false // The dynamic type is unknown.
+		}
+
+		if b, ok := t.(*types.Basic); ok && (b.Info()&types.IsUntyped != 0 || b.Kind() == types.Invalid) {
+			return nil, false // reflect.TypeOf(nil) or an invalid expression.
+		}
+
+		return t, true
+
+	case reflectTypeFor:
+		id, ok := ast.Unparen(call.Fun).(*ast.IndexExpr)
+		if !ok {
+			return nil, false
+		}
+
+		return p.TypesInfo.TypeOf(id.Index), true
+
+	case reflectElem:
+		sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return nil, false
+		}
+
+		t, ok := p.reflectType(sel.X)
+		if !ok {
+			return nil, false
+		}
+
+		ptr, ok := t.Underlying().(*types.Pointer)
+		if !ok {
+			return nil, false
+		}
+
+		return ptr.Elem(), true
+
+	default:
+		return nil, false
+	}
+}
@@ -19,17 +19,28 @@ package analyzer
 import (
 	"errors"
 	"go/ast"
+	"go/types"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/cmplint/internal/typeutil"
 )
 
 // option holds the configurable parameters for the analyzer.
 type option struct {
-	name    string
-	doc     string
-	checkis bool
+	name           string
+	doc            string
+	checkis        bool
+	checkunwrap    *bool // Defaults to checkis when nil.
+	checkzerosized bool
+	checksentinels bool
+	keyed          []string
+	allowTypes     []string
+	platforms      []string
+	audit          bool
+	nolint         bool
 }
 
 // run is the main analysis function for the analyzer.
@@ -39,19 +50,93 @@ func (o *option) run(a *analysis.Pass) (any, error) {
 		return nil, ErrNoInspector
 	}
 
-	p := pass{Pass: a, checkis: o.checkis}
+	p, err := o.newPass(a)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(o.platforms) > 0 {
+		platforms, err := platformsWith(o.platforms)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.platformFindings, err = o.platformFindings(a, platforms); err != nil {
+			return nil, err
+		}
+	}
+
+	p.analyze(in)
+
+	return any(nil), nil
+}
+
+// newPass creates the analyzer-specific [pass] for a, validating the configuration.
+func (o *option) newPass(a *analysis.Pass) (pass, error) {
+	keyed, err := keyedFunctionsWith(o.keyed)
+	if err != nil {
+		return pass{}, err
+	}
+
+	allowTypes, err := allowedTypesWith(o.allowTypes)
+	if err != nil {
+		return pass{}, err
+	}
+
+	checkunwrap := o.checkis
+	if o.checkunwrap != nil {
+		checkunwrap = *o.checkunwrap
+	}
+
+	return pass{
+		Pass:           a,
+		checkis:        o.checkis,
+		checkunwrap:    checkunwrap,
+		checkzerosized: o.checkzerosized,
+		checksentinels: o.checksentinels,
+		auditing:       o.audit,
+		nolint:         o.nolint,
+		keyed:          keyed,
+		allowTypes:     allowTypes,
+		isFacts:        make(map[*types.Func]*isFact),
+		zeroSizer:      newZeroSizer(a.TypesSizes),
+	}, nil
+}
+
+// analyze walks the syntax of the package and reports diagnostics.
+func (p pass) analyze(in *inspector.Inspector) {
+	p.directives = p.collectDirectives()
+	p.owners = p.typeParamOwners()
+	p.genericDeps = make(map[*types.Func][]int)
+	p.locals = p.collectLocals(in)
+
+	if p.checkis {
+		p.exportIsFacts(in)
+		p.acceptors = p.isAcceptors()
+	}
+
+	if p.checksentinels {
+		p.sentinels()
+	}
 
-	for n := range in.PreorderSeq((*ast.BinaryExpr)(nil), (*ast.CallExpr)(nil)) {
+	for n := range in.PreorderSeq((*ast.BinaryExpr)(nil), (*ast.CallExpr)(nil), (*ast.ReturnStmt)(nil)) {
 		switch n := n.(type) {
 		case *ast.BinaryExpr: // Process equality and inequality operations.
 			p.handleBinaryExpr(n)
 
 		case *ast.CallExpr: // Check for errors.Is(x, y) and testify functions.
 			p.handleCallExpr(n, functions)
+
+		case *ast.ReturnStmt: // Check for returned zero-sized errors.
+			if p.checksentinels {
+				p.handleReturnStmt(n)
+			}
 		}
 	}
 
-	return any(nil), nil
+	p.instantiations()
+	p.exportGenericFacts()
+	p.reportUnusedDirectives()
 }
 
 // ErrNoInspector is returned by the analyzer's Run method if the required
@@ -62,5 +147,25 @@ var ErrNoInspector = errors.New("inspector missing")
 // like configuration options. It provides helper methods for the analysis logic.
 type pass struct {
 	*analysis.Pass
-	checkis bool
+	checkis        bool
+	checkunwrap    bool
+	checkzerosized bool
+	checksentinels bool
+	auditing       bool
+	nolint         bool
+	keyed          map[typeutil.FuncName][]keyedArg
+	allowTypes     map[string]struct{}
+	directives     *directives
+	isFacts        map[*types.Func]*isFact
+	acceptors      map[string][]types.Type
+	zeroSizer      *zeroSizer
+	owners         map[*types.TypeParam]typeParamOwner
+	genericDeps    map[*types.Func][]int
+	locals         localVars
+
+	// platformFindings are diagnostics involving zero-sized types on other platforms.
+	platformFindings map[platformKey]*platformFinding
+
+	// zeroSizedRecord collects diagnostics involving zero-sized types when analyzing for another platform.
+	zeroSizedRecord map[platformKey]string
 }
@@ -0,0 +1,222 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// sentinelFact marks package-level error variables pointing to a zero-sized variable,
+// so comparisons with them in other packages can be reported.
+type sentinelFact struct {
+	// Type is the fully qualified zero-sized type the variable points to.
+	Type string
+}
+
+// AFact implements [analysis.Fact].
+func (*sentinelFact) AFact() {}
+
+// String implements [fmt.Stringer].
+func (f *sentinelFact) String() string {
+	return "zero-sized sentinel " + f.Type
+}
+
+// sentinels reports package-level error variables initialized with the address of a new zero-sized
+// variable, like `var ErrSkip = &Skip{}`, and exports facts for them.
+func (p pass) sentinels() {
+	for _, f := range p.Files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				vs, _ := spec.(*ast.ValueSpec)
+				if len(vs.Values) != len(vs.Names) {
+					continue // No values or multi-valued function call.
+				}
+
+				for i, name := range vs.Names {
+					t, ok := p.zeroSizedError(vs.Values[i])
+					if !ok || p.allowed(name, t) {
+						continue
+					}
+
+					p.ReportRangef(name,
+						"Sentinel error %q points to a new variable of zero-sized type %q, comparisons with it may be undefined; %s",
+						name.Name, p.typeString(t), zeroSizedAdvice(t))
+
+					if v, ok := p.TypesInfo.Defs[name].(*types.Var); ok {
+						p.ExportObjectFact(v, &sentinelFact{Type: types.TypeString(t, nil)})
+					}
+				}
+			}
+		}
+	}
+}
+
+// handleReturnStmt reports returned errors that are the address of a new zero-sized variable.
+// Only results of error type are considered, so constructors like `func newSkip() *skip` are not reported.
+func (p pass) handleReturnStmt(n *ast.ReturnStmt) {
+	var results *types.Tuple
+
+	for i, result := range n.Results {
+		t, ok := p.zeroSizedError(result)
+		if !ok || p.allowed(result, t) {
+			continue
+		}
+
+		if results == nil {
+			if results = p.enclosingResults(n); results == nil {
+				return
+			}
+		}
+
+		if results.Len() != len(n.Results) || !isErrorInterface(results.At(i).Type()) {
+			continue
+		}
+
+		p.ReportRangef(result,
+			"Returned error is the address of a new variable of zero-sized type %q, comparisons with it may be undefined; %s",
+			p.typeString(t), zeroSizedAdvice(t))
+	}
+}
+
+// zeroSizedAdvice suggests how to avoid pointers to the zero-sized error type t.
+// When t implements error itself, the value can be used instead of its address.
+func zeroSizedAdvice(t types.Type) string {
+	if types.Implements(t, errorType().Underlying().(*types.Interface)) {
+		return "use the value instead of its address"
+	}
+
+	return "use a value receiver for Error or a non-empty struct"
+}
+
+// enclosingResults returns the results of the innermost function containing the return statement n.
+func (p pass) enclosingResults(n *ast.ReturnStmt) *types.Tuple {
+	var results *types.Tuple
+
+	for _, f := range p.Files {
+		if !within(n.Pos(), f) {
+			continue
+		}
+
+		ast.Inspect(f, func(node ast.Node) bool {
+			if node == nil || !within(n.Pos(), node) {
+				return false
+			}
+
+			switch fn := node.(type) {
+			case *ast.FuncDecl:
+				if obj, ok := p.TypesInfo.Defs[fn.Name].(*types.Func); ok {
+					results = obj.Signature().Results()
+				}
+
+			case *ast.FuncLit:
+				if sig, ok := p.TypesInfo.TypeOf(fn).(*types.Signature); ok {
+					results = sig.Results()
+				}
+			}
+
+			return true
+		})
+	}
+
+	return results
+}
+
+// isErrorInterface reports whether t is an interface type implementing error, like error itself.
+func isErrorInterface(t types.Type) bool {
+	return types.IsInterface(t) && types.Implements(t, errorType().Underlying().(*types.Interface))
+}
+
+// zeroSizedError checks whether x is the address of a new variable of a zero-sized type T, where *T is an error.
+// Errors with an `Is(error) bool` method matching *T are excluded, since `errors.Is` is well-defined for them.
+func (p pass) zeroSizedError(x ast.Expr) (types.Type, bool) {
+	t, ok := p.isAddrOfCompLitOrNew(x)
+	if !ok || t == nil || !p.isZeroSized(t) {
+		return nil, false
+	}
+
+	ptr := types.NewPointer(t)
+	if !types.Implements(ptr, errorType().Underlying().(*types.Interface)) {
+		return nil, false
+	}
+
+	if fact := p.isFactOf(ptr); fact != nil && fact.matches(ptr) {
+		return nil, false
+	}
+
+	return t, true
+}
+
+// sentinelComparison reports comparisons with sentinel errors from other packages pointing to a
+// zero-sized variable. For error comparisons, only the target is considered.
+func (p pass) sentinelComparison(node ast.Node, left, right ast.Expr, isError bool) bool {
+	candidates := [...]struct{ sentinel, other ast.Expr }{{right, left}, {left, right}}
+
+	n := len(candidates)
+	if isError {
+		n = 1
+	}
+
+	for _, c := range candidates[:n] {
+		v := p.importedVar(c.sentinel)
+		if v == nil {
+			continue
+		}
+
+		var fact sentinelFact
+		if !p.ImportObjectFact(v, &fact) {
+			continue
+		}
+
+		p.ReportRangef(node,
+			"Result of comparison of %q with sentinel %q may be undefined, it points to a variable of zero-sized type %q",
+			p.exprToString(c.other), p.exprToString(c.sentinel), fact.Type)
+
+		return true
+	}
+
+	return false
+}
+
+// importedVar returns the package-level variable of another package x refers to, or nil.
+func (p pass) importedVar(x ast.Expr) *types.Var {
+	var id *ast.Ident
+
+	switch e := p.unconvert(x).(type) {
+	case *ast.Ident:
+		id = e
+
+	case *ast.SelectorExpr:
+		id = e.Sel
+
+	default:
+		return nil
+	}
+
+	v, ok := p.TypesInfo.Uses[id].(*types.Var)
+	if !ok || v.Pkg() == nil || v.Pkg() == p.Pkg || v.Parent() != v.Pkg().Scope() {
+		return nil
+	}
+
+	return v
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// suppression determines whether a diagnostic for an error comparison should be suppressed
+// and returns the reason. This is relevant for `errors.Is` calls, where certain patterns involving
+// `Is` or `Unwrap` methods might make the comparison legitimate despite involving a new address.
+func (p pass) suppression(t types.Type, other ast.Expr, isLeft bool) (reason string, ok bool) {
+	return p.targetSuppression(types.NewPointer(t), other, isLeft)
+}
+
+// targetSuppression determines whether a diagnostic for an error comparison with a target of type ptr,
+// usually the pointer *T to a new variable, should be suppressed and returns the reason.
+func (p pass) targetSuppression(ptr types.Type, other ast.Expr, isLeft bool) (reason string, ok bool) {
+	// The standard library `errors.Is(err, target)` function checks if `err` (or an error
+	// in its `Unwrap` tree) matches `target`. This matching can occur in several ways.
+	// For this linter, which flags `errors.Is(err, &T{})` (where `&T{}` is the `target`),
+	// we are concerned with three scenarios for suppression:
+
+	// When `err` has a concrete static type, we know exactly which methods `errors.Is` calls:
+	// The `Is(error) bool` method of `err` and, if it can be unwrapped, those of the wrapped errors.
+	// An error that cannot be unwrapped never consults the `Is` method of `target`.
+	if p.checkis && !isLeft && other != nil {
+		if errType := p.TypesInfo.TypeOf(other); errType != nil && !types.IsInterface(errType) {
+			if fact := p.isFactOf(errType); fact != nil && fact.matches(ptr) {
+				return fmt.Sprintf("%q has an Is method matching the target", p.typeString(errType)), true
+			}
+
+			if !canUnwrap(errType) {
+				return "", false
+			}
+
+			other = nil // The wrapped errors are unknown.
+		}
+	}
+
+	// 1. If `target` could be matched by an `Is(error) bool` method of `err`, assume it would
+	//    be the `Is(error) bool` method of `target` and suppress the diagnostic in this case.
+	//    The body of the method is analyzed to determine whether it could match `*T` at all,
+	//    or, if the newly created literal is `err`, the type of `target`.
+	if p.checkis {
+		var target types.Type = ptr
+		if isLeft {
+			target = p.TypesInfo.TypeOf(other)
+		}
+
+		fact := p.isFactOf(ptr)
+		if fact != nil && fact.matches(target) {
+			return fmt.Sprintf("%q has an Is method matching the target", p.typeString(ptr)), true
+		}
+
+		// When the newly created literal is `err`, identity comparisons with `target` may match.
+		if fact != nil && isLeft && fact.Compares {
+			return fmt.Sprintf("%q has an Is method comparing the target", p.typeString(ptr)), true
+		}
+	}
+
+	// 2. If `err` could dynamically be of a different type whose `Is(error) bool` method matches `*T`,
+	//    like `errorB.Is` type-asserting against `*errorA`, suppress the diagnostic too.
+	if p.checkis && !isLeft {
+		if acceptor, ok := p.couldBeAcceptor(ptr, other); ok {
+			return fmt.Sprintf("the error could be %q with an Is method matching the target", p.typeString(acceptor)), true
+		}
+	}
+
+	// 3. `err.Unwrap()`: If `err` is the newly created literal (`isLeft` is true),
+	//    and its type `*T` implements an `Unwrap` method, `errors.Is` will traverse
+	//    the unwrapped errors. The comparison might then be valid against an unwrapped error.
+	//    Thus, we suppress the diagnostic in this case.
+	if p.checkunwrap && isLeft && canUnwrap(ptr) {
+		return fmt.Sprintf("%q has an Unwrap method", p.typeString(ptr)), true
+	}
+
+	// We do not have dynamic runtime types, these heuristics rely on static type information
+	// and seem to work well in practice.
+
+	return "", false
+}
+
+// canUnwrap reports whether t implements `Unwrap() error` or `Unwrap() []error`.
+func canUnwrap(t types.Type) bool {
+	return types.Implements(t, errorUnwrapInterface) || types.Implements(t, errorUnwrapArrayInterface)
+}
+
+// allowed reports whether comparisons with new variables of type t are allowed by configuration.
+func (p pass) allowed(n ast.Node, t types.Type) bool {
+	if len(p.allowTypes) == 0 || t == nil {
+		return false
+	}
+
+	name := qualifiedTypeName(t)
+	if _, ok := p.allowTypes[name]; !ok {
+		return false
+	}
+
+	p.audit(n, t, fmt.Sprintf("type %q is allowed", name))
+
+	return true
+}
+
+// audit reports a suppressed diagnostic in audit mode.
+func (p pass) audit(n ast.Node, t types.Type, reason string) {
+	if !p.auditing {
+		return
+	}
+
+	p.Pass.ReportRangef(n, "Suppressed comparison with address of new variable of type %q: %s", p.typeString(t), reason)
+}
+
+// qualifiedTypeName returns the fully qualified name of t, like "example.com/pkg.Type".
+// Instantiated generic types are named by their origin.
+func qualifiedTypeName(t types.Type) string {
+	if named, ok := types.Unalias(t).(*types.Named); ok {
+		obj := named.Origin().Obj()
+		if obj.Pkg() == nil {
+			return obj.Name()
+		}
+
+		return obj.Pkg().Path() + "." + obj.Name()
+	}
+
+	return types.TypeString(t, nil)
+}
+
+// ErrInvalidType is returned for malformed type names in the allowlist.
+var ErrInvalidType = errors.New("invalid type name")
+
+// typesFlag is a repeatable [flag.Value] collecting space-separated fully qualified type names.
+type typesFlag []string
+
+// String implements [flag.Value].
+func (f *typesFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return strings.Join(*f, " ")
+}
+
+// Set implements [flag.Value].
+func (f *typesFlag) Set(names string) error {
+	for name := range strings.FieldsSeq(names) {
+		if err := validateTypeName(name); err != nil {
+			return err
+		}
+
+		*f = append(*f, name)
+	}
+
+	return nil
+}
+
+// validateTypeName checks that name has the form "<path>.<name>".
+func validateTypeName(name string) error {
+	i := strings.LastIndexByte(name, '.')
+	if i <= 0 || i == len(name)-1 || strings.LastIndexByte(name, '/') > i {
+		return fmt.Errorf("%w %q: expected \"<path>.<name>\"", ErrInvalidType, name)
+	}
+
+	return nil
+}
+
+// allowedTypesWith returns the set of allowed type names.
+func allowedTypesWith(names []string) (map[string]struct{}, error) {
+	if len(names) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	allowed := make(map[string]struct{}, len(names))
+
+	for _, name := range names {
+		if err := validateTypeName(name); err != nil {
+			return nil, err
+		}
+
+		allowed[name] = struct{}{}
+	}
+
+	return allowed, nil
+}
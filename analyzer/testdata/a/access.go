@@ -0,0 +1,68 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"slices"
+	"sync/atomic"
+)
+
+type inner struct{ _ int }
+
+type outer struct {
+	Inner inner
+	Items [3]inner
+	Ptr   *inner
+	*embedded
+}
+
+type embedded struct{ Value int }
+
+func FieldAddress(p *inner, i int, e *struct{}) {
+	_ = p == &new(outer).Inner // want "Result of comparison of \"p\" with address of \"\\.Inner\" of new variable of type \"outer\" is always false"
+
+	_ = &(&outer{}).Inner != p // want "address of \"\\.Inner\" of new variable of type \"outer\""
+
+	_ = p == &(*new(outer)).Inner // want "address of \"\\.Inner\" of new variable of type \"outer\""
+
+	_ = p == &new(outer).Items[i] // want "address of \"\\.Items\\[i\\]\" of new variable of type \"outer\""
+
+	_ = p == &[]inner{{}}[0] // want "address of \"\\[0\\]\" of new variable of type \"\\[\\]inner\""
+
+	_ = p == &new([3]inner)[1] // want "address of \"\\[1\\]\" of new variable of type \"\\[3\\]inner\""
+
+	_ = p == &[]outer{{}}[0].Items[2] // want "address of \"\\[0\\]\\.Items\\[2\\]\" of new variable of type \"\\[\\]outer\""
+
+	_ = p == &(&new(outer).Items)[0] // want "address of \"\\.Items\\[0\\]\" of new variable of type \"outer\""
+
+	_ = e == &[]struct{}{{}}[0] // want "address of zero-sized \"\\[0\\]\" of new variable of type \"\\[\\]struct{}\" is false or undefined"
+
+	_ = slices.Contains([]*inner{p}, &new(outer).Inner) // want "elements of .* with address of \"\\.Inner\" of new variable"
+}
+
+func FieldAddressValid(p *inner, o *outer, s []inner, q *int) {
+	_ = p == &o.Inner
+
+	_ = p == &s[0]
+
+	_ = p == new(outer).Ptr
+
+	_ = q == &new(outer).Value // Promoted through an embedded pointer.
+
+	var v atomic.Pointer[inner]
+	_ = v.CompareAndSwap(&new(outer).Inner, p) // want "address of \"\\.Inner\" of new variable of type \"outer\" is always false, the swap will never happen"
+}
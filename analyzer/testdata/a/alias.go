@@ -0,0 +1,54 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"errors"
+	"testing"
+
+	"test/wrapper"
+)
+
+type boxedError[T any] struct{ value T }
+
+func (e *boxedError[T]) Error() string { return "generic error" }
+
+type genericAlias[T any] = boxedError[T]
+
+type intErrorAlias = genericAlias[int]
+
+type nodeAlias = node
+
+func GenericAlias(err error, p *node) {
+	_ = errors.Is(err, &genericAlias[int]{}) // want "Result of comparison of \"err\" with address of new variable of type \"boxedError\\[int\\]\" is always false"
+
+	_ = errors.Is(err, new(intErrorAlias)) // want "of type \"boxedError\\[int\\]\" is always false"
+
+	_ = p == &nodeAlias{} // want "of type \"node\" is always false"
+}
+
+func TestWrapper(t *testing.T) {
+	p := &node{}
+
+	w := wrapper.New(t)
+	w.Same(p, &node{})                             // want "Result of comparison of \"p\" with address of new variable of type \"node\" is always false"
+	w.NotSame(p, new(nodeAlias))                   // want "of type \"node\" is always false"
+	(*wrapper.Assertions).Same(w, p, &nodeAlias{}) // want "of type \"node\" is always false"
+
+	var v wrapper.Pointer[node]
+	_ = v.CompareAndSwap(&node{}, p) // want "the swap will never happen"
+}
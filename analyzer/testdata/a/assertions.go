@@ -0,0 +1,105 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"testing"
+
+	qt1 "github.com/frankban/quicktest"
+	"github.com/go-quicktest/qt"
+	"github.com/matryer/is"
+	"github.com/onsi/gomega"
+	"github.com/smarty/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestGomega(t *testing.T) {
+	g := gomega.NewWithT(t)
+	p := &node{}
+
+	g.Expect(p).To(gomega.BeIdenticalTo(&node{})) // want "comparison of actual value with address of new variable of type \"node\" is always false"
+
+	g.Expect(p).To(gomega.BeIdenticalTo(p))
+
+	g.Expect(p).To(gomega.Equal(&node{}))
+
+	var err error
+
+	g.Expect(err).To(gomega.MatchErrorStrictly(&myError1{})) // want "is false or undefined"
+
+	g.Expect(err).To(gomega.MatchErrorStrictly(&myErrorWithIs{}))
+
+	g.Expect(err).To(gomega.MatchError(&myError1{}))
+}
+
+func TestQuicktest(t *testing.T) {
+	c := qt1.New(t)
+	p := &node{}
+
+	c.Assert(p, qt1.Equals, &node{}) // want "is always false"
+
+	c.Check(p, qt1.DeepEquals, &node{})
+
+	var err error
+
+	c.Assert(err, qt1.ErrorIs, &myError1{}) // want "is false or undefined"
+
+	c.Assert(err, qt1.ErrorIs, &myErrorWithIs{})
+
+	qt1.Assert(t, p, qt1.Equals, new(node)) // want "is always false"
+
+	qt1.Check(t, p, qt1.Not(qt1.Equals), &node{})
+
+	qt.Assert(t, qt.Equals(p, &node{})) // want "is always false"
+
+	qt.Assert(t, qt.ErrorIs(err, &myError1{})) // want "is false or undefined"
+
+	qt.Assert(t, qt.DeepEquals(p, &node{}))
+}
+
+func TestGoTestToolsErrorIs(t *testing.T) {
+	var err error
+
+	_ = cmp.ErrorIs(err, &myError1{}) // want "is false or undefined"
+
+	_ = cmp.ErrorIs(err, &myErrorWithIs{})
+}
+
+func TestConvey(t *testing.T) {
+	p := &node{}
+
+	Convey("pointers", t, func(c C) {
+		So(p, ShouldPointTo, &node{}) // want "is always false"
+
+		So(p, ShouldNotPointTo, new(node)) // want "is always false"
+
+		So(p, ShouldEqual, &node{})
+
+		SoMsg("msg", p, ShouldPointTo, &node{}) // want "is always false"
+
+		c.So(p, ShouldPointTo, &node{}) // want "is always false"
+	})
+
+	_ = assertions.ShouldPointTo(p, &node{}) // want "is always false"
+}
+
+func TestIs(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(&node{}, &node{})
+}
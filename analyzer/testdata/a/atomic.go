@@ -0,0 +1,50 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+type node struct{ _ int }
+
+func Atomic() {
+	var p atomic.Pointer[node]
+
+	_ = p.CompareAndSwap(&node{}, new(node)) // want "is always false, the swap will never happen"
+
+	_ = p.CompareAndSwap(nil, &node{})
+
+	_ = p.CompareAndSwap(p.Load(), &node{})
+
+	_ = (*atomic.Pointer[node]).CompareAndSwap(&p, new(node), nil) // want "the swap will never happen"
+
+	var e atomic.Pointer[struct{}]
+
+	_ = e.CompareAndSwap(&struct{}{}, nil) // want "is false or undefined, the swap may never happen"
+
+	var v atomic.Value
+
+	_ = v.CompareAndSwap(&node{}, &node{}) // want "type \"node\" is always false"
+
+	var u unsafe.Pointer
+
+	_ = atomic.CompareAndSwapPointer(&u, unsafe.Pointer(&node{}), nil) // want "the swap will never happen"
+
+	_ = atomic.CompareAndSwapPointer(&u, nil, unsafe.Pointer(&node{}))
+}
@@ -0,0 +1,36 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/markers"
+)
+
+type markedError struct{ msg string }
+
+func (e *markedError) Error() string { return e.msg }
+
+func CockroachErrors() {
+	err := errors.New("x")
+
+	_ = errors.Is(err, &markedError{msg: "x"}) // want "Comparison of \"err\" with address of new variable of type \"markedError\" relies on error mark equivalence"
+
+	_ = markers.Is(err, &myError1{}) // want "relies on error mark equivalence"
+
+	_ = errors.Is(err, err)
+}
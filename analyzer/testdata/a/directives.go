@@ -0,0 +1,63 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "errors"
+
+type ignored struct{ _ int }
+
+func Directives(p *ignored, err error) {
+	_ = p == &ignored{} //cmplint:ignore compared for documentation
+
+	//cmplint:ignore the whole statement is exempt
+	if p == (&ignored{}) ||
+		p != new(ignored) {
+		_ = p == &ignored{} // want "is always false"
+	}
+
+	_ = p == &ignored{} //nolint:cmplint // exempt
+
+	_ = p == &ignored{} //nolint:errcheck,cmplint // exempt
+
+	_ = p == &ignored{} //nolint // exempt
+
+	_ = p == &ignored{} //lint:ignore cmplint exempt
+
+	//lint:ignore SA4000,cmplint exempt
+	_ = errors.Is(err, &myError1{})
+
+	_ = p == &ignored{} //nolint:errcheck // want "is always false"
+
+	_ = p == &ignored{} //cmplint:ignored // want "is always false"
+
+	// want +1 "requires a reason" "is always false"
+	_ = p == &ignored{} //cmplint:ignore
+
+	// want +1 "requires a reason" "is always false"
+	_ = p == &ignored{} //lint:ignore cmplint
+
+	_ = p == nil //cmplint:ignore stale // want "Unused //cmplint:ignore directive"
+
+	// want +1 "//nolint:cmplint directive requires a reason" "is always false"
+	_ = p == &ignored{} //nolint:cmplint
+
+	_ = p == nil //nolint:cmplint // stale // want "Unused //nolint:cmplint directive"
+
+	_ = p == nil //nolint:all // not reported
+
+	_ = p == nil //nolint // not reported
+}
@@ -59,6 +59,8 @@ func Errors() {
 	var e myError1
 	_ = errors.Is(nil, &e)
 
+	_ = errors.Is(nil, error(&myError1{})) // want "is always false"
+
 	_ = errors.As(myError1{}, &myError1{})
 
 	_ = errors.Join(&myError1{}, &myError1{})
@@ -93,7 +95,7 @@ func (myErrorWithIs) Error() string {
 	return "my error with is"
 }
 
-func (myErrorWithIs) Is(err error) bool {
+func (myErrorWithIs) Is(err error) bool { // want Is:"matches \\*test/a.myErrorWithIs"
 	_, ok := err.(*myErrorWithIs)
 
 	return ok
@@ -120,9 +122,9 @@ func (myErrorWithUnwrapArray) Unwrap() []error {
 }
 
 func Errors4() {
-	_ = errors.Is(&myErrorWithIs{}, &myError1{})
+	_ = errors.Is(&myErrorWithIs{}, &myError1{}) // want "is false or undefined"
 
-	_ = errors.Is(&struct{ *myErrorWithIs }{}, &myError1{})
+	_ = errors.Is(&struct{ *myErrorWithIs }{}, &myError1{}) // want "is always false"
 
 	_ = errors.Is(&myErrorWithUnwrap{}, os.ErrProcessDone)
 
@@ -19,6 +19,8 @@ package a
 import (
 	"errors"
 	"fmt"
+
+	"test/generic"
 )
 
 type genericError[T fmt.Stringer] struct {
@@ -33,14 +35,33 @@ type empty struct{}
 
 func (empty) String() string { return "empty" }
 
-func Generic[T fmt.Stringer]() {
-	_ = errors.Is(&genericError[T]{}, &genericError[T]{}) // want "is always false"
+func Generic[T fmt.Stringer]() { // want Generic:"undefined with zero-sized type parameters \\[0\\]"
+	_ = errors.Is(&genericError[T]{}, &genericError[T]{}) // want `is false, or undefined if instantiated with zero-sized T$`
 
 	_ = errors.Is(&genericError[empty]{}, &genericError[empty]{}) // want "is false or undefined"
 }
 
+type sizedError[T fmt.Stringer] struct {
+	_ int
+	e T
+}
+
+func (g sizedError[T]) Error() string {
+	return "error: " + g.e.String()
+}
+
 func Generic2[T, U fmt.Stringer]() {
-	Generic[T]()
+	Generic[T]() // Not propagated to instantiations of Generic2.
+
+	Generic[empty]()
+
+	_ = errors.Is(&sizedError[U]{}, &sizedError[U]{}) // want "is always false"
+
+	_ = generic.IsNew[struct{}](nil) // want `Instantiation of IsNew with zero-sized type "struct{}" for T makes comparisons with new variables in it undefined`
+
+	_ = generic.IsNew(&empty{}) // want "Instantiation of IsNew"
+
+	_ = generic.IsNew(new(int))
 
 	Generic2[T, U]()
 }
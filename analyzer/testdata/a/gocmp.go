@@ -0,0 +1,52 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func GoCmp() {
+	var err error
+
+	_ = cmp.Equal(err, &myError1{}, cmpopts.EquateErrors()) // want "is false or undefined"
+
+	_ = cmp.Diff(err, &myError1{}, cmpopts.EquateEmpty(), cmpopts.EquateErrors()) // want "is false or undefined"
+
+	_ = cmp.Equal(err, &myError1{}, cmp.Options{cmpopts.EquateErrors()}) // want "is false or undefined"
+
+	_ = cmp.Equal(err, &myErrorWithIs{}, cmpopts.EquateErrors())
+
+	_ = cmp.Equal(err, &myError1{})
+
+	_ = cmp.Diff(err, &myError1{}, cmpopts.EquateEmpty())
+
+	opts := []cmp.Option{cmpopts.EquateErrors()}
+	_ = cmp.Equal(err, &myError1{}, opts...)
+}
+
+type row struct{ S []string }
+
+func GoCmpNoErrors(p *node) {
+	_ = cmp.Diff(p, &node{}, cmpopts.EquateErrors())
+
+	_ = cmp.Equal(row{}, row{S: []string{"x"}}, cmpopts.EquateErrors())
+
+	var err error
+	_ = cmp.Equal(err, row{S: []string{"x"}}, cmpopts.EquateErrors())
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"unique"
+	"weak"
+)
+
+func Handles() {
+	p := &node{}
+
+	_ = weak.Make(p) == weak.Make(&node{}) // want "type \"node\" is always false"
+
+	_ = weak.Make(new(struct{})) != weak.Make(&struct{}{}) // want "is false or undefined"
+
+	_ = weak.Make(p) == weak.Make(p)
+
+	var h unique.Handle[*node]
+
+	_ = h == unique.Make(&node{}) // want "is always false"
+
+	_ = h == unique.Make(p)
+
+	_ = unique.Make("node") == unique.Make("node")
+}
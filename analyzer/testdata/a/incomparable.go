@@ -0,0 +1,53 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type detailsError struct{ Details []string }
+
+func (e detailsError) Error() string { return "details error" }
+
+type mapError map[string]int
+
+func (e mapError) Error() string { return "map error" }
+
+type matchingError struct{ Details []string }
+
+func (e matchingError) Error() string { return "matching error" }
+
+func (e matchingError) Is(target error) bool { // want Is:"matches .*matchingError"
+	_, ok := target.(matchingError)
+
+	return ok
+}
+
+func Incomparable(err error, t assert.TestingT, m mapError) {
+	_ = errors.Is(err, detailsError{Details: []string{"x"}}) // want "Result of comparison of \"err\" with non-comparable target of type \"detailsError\" is always false"
+
+	_ = errors.Is(err, m) // want "non-comparable target of type \"mapError\" is always false"
+
+	assert.ErrorIs(t, err, detailsError{}) // want "non-comparable target of type \"detailsError\" is always false"
+
+	_ = errors.Is(err, matchingError{Details: []string{"x"}})
+
+	_ = errors.Is(err, error(detailsError{})) // want "non-comparable target of type \"detailsError\" is always false"
+}
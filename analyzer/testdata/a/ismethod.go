@@ -0,0 +1,187 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"encoding/json"
+	"errors"
+
+	"test/iserrors"
+)
+
+type identityError struct{ _ int }
+
+func (e *identityError) Error() string { return "identity error" }
+
+func (e *identityError) Is(target error) bool { return e == target } // want Is:"matches no target, compares identity"
+
+type switchError struct{ _ int }
+
+func (e *switchError) Error() string { return "switch error" }
+
+func (e *switchError) Is(target error) bool { // want Is:"matches .*switchError, .*otherError"
+	switch target.(type) {
+	case *switchError, *otherError:
+		return true
+
+	default:
+		return false
+	}
+}
+
+type otherError struct{ _ int }
+
+func (e *otherError) Error() string { return "other error" }
+
+type neverError struct{ _ int }
+
+func (e *neverError) Error() string { return "never error" }
+
+func (e *neverError) Is(error) bool { return false } // want Is:"matches no target"
+
+type anyError struct{ _ int }
+
+func (e *anyError) Error() string { return "any error" }
+
+func (e *anyError) Is(error) bool { return true } // want Is:"matches any target"
+
+func IsMethod(err error) {
+	_ = errors.Is(err, &identityError{}) // want "is always false"
+
+	_ = errors.Is(err, &switchError{})
+
+	_ = errors.Is(&switchError{}, &identityError{}) // want "is always false"
+
+	_ = errors.Is(&switchError{}, err)
+
+	_ = errors.Is(err, &neverError{}) // want "is always false"
+
+	_ = errors.Is(err, &anyError{})
+
+	_ = errors.Is(&anyError{}, &myError1{})
+}
+
+var errCompared = errors.New("compared")
+
+type comparingError struct{ _ int }
+
+func (e *comparingError) Error() string { return "comparing error" }
+
+func (e *comparingError) Is(target error) bool { return target == errCompared } // want Is:"matches no target, compares identity"
+
+func IsMethodCompares(err error) {
+	_ = errors.Is(&comparingError{}, errCompared)
+
+	_ = errors.Is(err, &comparingError{}) // want "is always false"
+}
+
+type typedError[P any] struct{ Value P }
+
+func (e *typedError[P]) Error() string { return "typed error" }
+
+func (e *typedError[P]) Is(target error) bool { // want Is:"matches \\*test/a.typedError\\[\\.\\.\\.\\], \\*test/a.otherError$"
+	switch target.(type) {
+	case *typedError[P], *otherError:
+		return true
+
+	default:
+		return false
+	}
+}
+
+type concreteError struct{ _ int }
+
+func (e *concreteError) Error() string { return "concrete error" }
+
+func (e *concreteError) Is(target error) bool { // want Is:"matches \\*test/a.typedError\\[int\\]$"
+	_, ok := target.(*typedError[int])
+
+	return ok
+}
+
+func IsMethodGeneric(err error, c *concreteError) {
+	_ = errors.Is(err, &typedError[int]{})
+
+	_ = errors.Is(err, &typedError[struct{}]{})
+
+	_ = errors.Is(c, &typedError[int]{})
+
+	_ = errors.Is(c, &typedError[string]{}) // want "is always false"
+
+	_ = errors.Is(err, &iserrors.GenericError[int]{})
+
+	_ = errors.Is(err, &iserrors.GenericError[struct{}]{})
+}
+
+func IsMethodImported(err error) {
+	_ = errors.Is(err, &iserrors.CodeError{Code: 1})
+
+	_ = errors.Is(err, &iserrors.IdentityError{}) // want "is always false"
+
+	_ = errors.Is(err, &iserrors.AnyError{})
+}
+
+type errorA struct{ Code int }
+
+func (e *errorA) Error() string { return "error a" }
+
+type errorB struct{ Code int }
+
+func (e *errorB) Error() string { return "error b" }
+
+func (e *errorB) Is(err error) bool { // want Is:"matches .*errorA"
+	if err, ok := err.(*errorA); ok {
+		return e.Code == err.Code
+	}
+
+	return false
+}
+
+type temporary interface {
+	error
+	Temporary() bool
+}
+
+func CrossType(err error, b *errorB, e *myError1, t temporary) {
+	_ = errors.Is(err, &errorA{100})
+
+	_ = errors.Is(b, &errorA{100})
+
+	_ = errors.Is(e, &errorA{100}) // want "is always false"
+
+	_ = errors.Is(t, &errorA{100}) // want "is always false"
+
+	_ = errors.Is(err, &iserrors.TimeoutError{})
+
+	_ = errors.Is(e, &iserrors.TimeoutError{}) // want "is always false"
+}
+
+func newMyError1() *myError1 { return nil }
+
+func StaticType(err *json.SyntaxError, b *errorB, w *myErrorWithUnwrap) {
+	_ = errors.Is(err, &myErrorWithIs{}) // want "is false or undefined"
+
+	_ = errors.Is(newMyError1(), &myErrorWithIs{}) // want "is false or undefined"
+
+	_ = errors.Is(b, &myErrorWithIs{}) // want "is false or undefined"
+
+	_ = errors.Is(b, &errorA{})
+
+	_ = errors.Is(w, &myErrorWithIs{})
+
+	_ = errors.Is(w, &errorA{})
+}
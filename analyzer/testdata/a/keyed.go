@@ -0,0 +1,76 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"container/list"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+type key struct{ _ int }
+
+func SyncMap() {
+	var m sync.Map
+
+	_, _ = m.Load(&key{}) // want "The key of \\(sync.Map\\).Load is the address of new variable of type \"key\" and will never match"
+
+	_, _ = m.LoadOrStore(new(key), 1) // want "The key of"
+
+	_, _ = m.LoadAndDelete(&key{}) // want "The key of"
+
+	m.Delete(&struct{}{}) // want "zero-sized variable of type \"struct{}\" and may never match"
+
+	_ = m.CompareAndDelete("k", &key{}) // want "The old value of"
+
+	_ = m.CompareAndSwap("k", new(key), &key{}) // want "The old value of"
+
+	m.Store(&key{}, 1)
+
+	k := &key{}
+	_, _ = m.Load(k)
+}
+
+func List() {
+	l := list.New()
+	e := l.PushBack(1)
+
+	l.Remove(&list.Element{}) // want "The element of \\(container/list.List\\).Remove"
+
+	l.MoveToFront(new(list.Element)) // want "The element of"
+
+	l.MoveBefore(e, &list.Element{}) // want "The mark of"
+
+	_ = l.InsertAfter(&key{}, &list.Element{}) // want "The mark of"
+
+	_ = l.InsertAfter(&key{}, e)
+
+	(*list.List).MoveToBack(l, new(list.Element)) // want "The element of"
+
+	l.Remove(e)
+}
+
+func Cache() {
+	c, _ := lru.New[*key, int](10)
+
+	_, _ = c.Get(&key{}) // want "The key of \\(github.com/hashicorp/golang-lru/v2.Cache\\).Get"
+
+	_ = c.Contains(new(key)) // want "The key of"
+
+	_ = c.Add(&key{}, 1)
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "errors"
+
+type valueError struct{ code int }
+
+func (e valueError) Error() string { return "value error" }
+
+func (e valueError) Is(target error) bool { // want Is:"matches no target, compares identity"
+	return target == &e // want "Result of comparison of \"target\" with address of receiver \"e\" is always false, it points to a copy made for this call"
+}
+
+type point struct{ x, y int }
+
+func (pt *point) move() { pt.x++ }
+
+func match(v point, p *point) bool {
+	return p == &v // want "address of parameter \"v\" is always false"
+}
+
+func matchLocal(p *point) bool {
+	v := *p
+
+	return &v == p // want "address of local variable \"v\" is always false, its address is not taken elsewhere"
+}
+
+func matchEmpty(v struct{}, p *struct{}) bool {
+	return p == &v // want "address of zero-sized parameter \"v\" is false or undefined"
+}
+
+func matchError(err error, e valueError) bool {
+	return errors.Is(err, &e) // want "address of parameter \"e\" is always false"
+}
+
+func LocalsValid(v point, p *point, points []point) {
+	q := &v
+	_ = p == &v // Address is taken before.
+	_ = q
+
+	w := v
+	w.move() // Implicit address taking.
+	_ = p == &w
+
+	var arr [2]point
+	_ = arr[:]
+	_ = p == &arr[0]
+
+	for _, pt := range points {
+		_ = p == &pt // want "address of loop variable \"pt\" is always false"
+	}
+
+	u := v
+	_ = &u == nil
+}
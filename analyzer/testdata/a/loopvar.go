@@ -0,0 +1,70 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+type item struct{ _ int }
+
+func LoopVar(p *item, items []item, arr *[2]item) {
+	for i, v := range items {
+		if p == &v { // want "Result of comparison of \"p\" with address of loop variable \"v\" is always false, it is a new variable in each iteration"
+			_ = i
+		}
+	}
+
+	for i, v := range arr {
+		_ = &v != p // want "address of loop variable \"v\" is always false"
+		_ = i
+	}
+
+	for i := 0; i < len(items); i++ {
+		_ = &i == nil
+	}
+
+	var q *int
+	for i := 0; i < 3; i++ {
+		_ = q == &i // want "address of loop variable \"i\" is always false"
+	}
+
+	for _, v := range []struct{}{{}} {
+		_ = &v == new(struct{}) // want "with address of new zero-sized variable"
+	}
+
+	var e *struct{}
+	for _, v := range []struct{}{{}} {
+		_ = e == &v // want "address of zero-sized loop variable \"v\" is false or undefined"
+	}
+}
+
+func LoopVarValid(items []item, p *item) {
+	var last *item
+	for _, v := range items {
+		_ = last == &v // Assigned in the loop.
+		last = &v
+	}
+
+	found := p
+	for _, v := range items {
+		_ = found == &v // Assigned in a closure.
+	}
+
+	func() { found = nil }()
+
+	for _, v := range items {
+		q := &v
+		_ = q == &v
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build go1.26
+
+package a
+
+type celsius float64
+
+func answer() int { return 42 }
+
+func NewExpr(i *int, s *string, c *celsius, pp **int) {
+	_ = i == new(42) // want "Result of comparison of \"i\" with address of new variable of type \"int\" is always false"
+
+	_ = new("x") != s // want "is always false"
+
+	_ = c == new(celsius(20)) // want "of type \"celsius\" is always false"
+
+	_ = i == new(answer()) // want "is always false"
+
+	_ = i == new(-answer()) // want "is always false"
+
+	_ = *pp == new(1+2) // want "is always false"
+
+	_ = new(struct{}{}) == new(struct{}{}) // want "is false or undefined"
+
+	_ = new(any(nil)) == nil // want "is always false"
+}
+
+func NewExprComparison(b *bool, x, y int) {
+	_ = b == new(x == y) // want "is always false"
+}
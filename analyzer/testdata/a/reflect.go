@@ -0,0 +1,65 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+type record struct{ _ int }
+
+func Reflect(x any, p *record, v reflect.Value, t reflect.Type) {
+	_ = x == reflect.New(reflect.TypeFor[record]()).Interface() // want "Result of comparison of \"x\" with address of new variable of type \"record\" is always false"
+
+	_ = reflect.New(reflect.TypeOf(record{})).Interface() != x // want "of type \"record\" is always false"
+
+	_ = x == reflect.New(reflect.TypeOf(p).Elem()).Interface() // want "of type \"record\" is always false"
+
+	_ = x == reflect.New(reflect.TypeOf((*struct{})(nil)).Elem()).Interface() // want "new zero-sized variable of type \"struct{}\" is false or undefined"
+
+	_ = reflect.ValueOf(p).Equal(reflect.New(reflect.TypeFor[record]())) // want "Result of comparison of \"reflect.ValueOf\\(p\\)\" with address of new variable of type \"record\" is always false"
+
+	_ = reflect.ValueOf(&record{}).Equal(v) // want "of type \"record\" is always false"
+
+	_ = reflect.Value.Equal(v, reflect.ValueOf(new(record))) // want "of type \"record\" is always false"
+
+	_ = reflect.ValueOf(p).Pointer() == reflect.ValueOf(&record{}).Pointer() // want "of type \"record\" is always false"
+
+	_ = unsafe.Pointer(p) == reflect.ValueOf(new(record)).UnsafePointer() // want "of type \"record\" is always false"
+}
+
+func ReflectValid(x any, p *record, v reflect.Value, t reflect.Type, m *sync.Map) {
+	_ = x == reflect.New(t).Interface() // The type is unknown.
+
+	_ = x == reflect.New(reflect.TypeOf(x)).Interface()
+
+	_ = x == reflect.New(reflect.TypeOf(nil)).Interface()
+
+	_ = v.Equal(reflect.ValueOf(p))
+
+	_ = x == reflect.ValueOf(record{}).Interface()
+
+	_ = x == reflect.ValueOf(new(record)).Elem().Interface()
+
+	// Reflection is only considered in direct comparisons.
+	_, _ = m.Load(reflect.New(reflect.TypeFor[record]()).Interface())
+
+	y := reflect.ValueOf(&record{}).Interface()
+	_ = x == y
+}
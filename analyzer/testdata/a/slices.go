@@ -0,0 +1,63 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"maps"
+	"slices"
+
+	xmaps "golang.org/x/exp/maps"
+	xslices "golang.org/x/exp/slices"
+)
+
+func Slices() {
+	var ptrs []*node
+
+	_ = slices.Contains(ptrs, &node{}) // want "comparison of elements of \"ptrs\" with address of new variable of type \"node\" is always false"
+
+	_ = slices.Index(ptrs, new(node)) // want "is always false"
+
+	_ = slices.Contains(ptrs, nil)
+
+	var errs []error
+
+	_ = slices.Index(errs, error(&myError1{})) // want "is false or undefined"
+
+	_ = slices.Equal(ptrs, []*node{&node{}}) // want "elements of \"ptrs\""
+
+	_ = slices.Equal([]*node{nil, 1: new(node)}, ptrs) // want "elements of \"ptrs\""
+
+	_ = slices.Equal(ptrs, []*node{nil})
+
+	_ = xslices.Contains(ptrs, &node{}) // want "is always false"
+
+	_ = xslices.Equal(ptrs, []*node{&node{}}) // want "is always false"
+}
+
+func Maps() {
+	var m map[string]*node
+
+	_ = maps.Equal(m, map[string]*node{"a": &node{}}) // want "elements of \"m\""
+
+	var k map[*node]int
+
+	_ = maps.Equal(map[*node]int{&node{}: 1}, k) // want "elements of \"k\""
+
+	_ = maps.Equal(m, map[string]*node{"a": nil})
+
+	_ = xmaps.Equal(m, map[string]*node{"a": new(node)}) // want "is always false"
+}
@@ -66,3 +66,23 @@ func TestTestify(t *testing.T) {
 	s.Equal(err, &myError1{})
 	r.Equal(err, &myError1{})
 }
+
+func TestTestifySame(t *testing.T) {
+	p := &node{}
+
+	assert.Same(t, p, &node{})             // want "is always false"
+	assert.Samef(t, p, new(node), "")      // want "is always false"
+	assert.NotSame(t, p, &node{})          // want "is always false"
+	assert.NotSamef(t, &myError1{}, p, "") // want "is false or undefined"
+
+	require.Same(t, p, &node{})    // want "is always false"
+	require.NotSame(t, p, &node{}) // want "is always false"
+
+	assert.Same(t, p, p)
+
+	var s suite.Suite
+
+	s.Same(p, &node{})                                  // want "is always false"
+	s.Require().NotSamef(p, &node{}, "")                // want "is always false"
+	(*assert.Assertions).Same(s.Assertions, p, &node{}) // want "is always false"
+}
@@ -68,3 +68,19 @@ func Errors4() {
 
 	_ = errors.Is(&myErrorWithUnwrapArray{}, os.ErrProcessDone) // want "is false or undefined"
 }
+
+type detailsErrorWithIs struct{ Details []string }
+
+func (detailsErrorWithIs) Error() string {
+	return "details error with is"
+}
+
+func (detailsErrorWithIs) Is(err error) bool {
+	_, ok := err.(detailsErrorWithIs)
+
+	return ok
+}
+
+func Incomparable(err error, target detailsErrorWithIs) {
+	_ = errors.Is(err, target) // want "non-comparable target of type \"detailsErrorWithIs\" is always false"
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package c
+
+type cache struct{}
+
+func (cache) lookup(_ *entry) bool { return false }
+
+func (cache) put(_ *entry, _ int) {}
+
+func register(_ string, _ *entry) {}
+
+type entry struct{ _ int }
+
+func Cache() {
+	var c cache
+
+	_ = c.lookup(&entry{}) // want "The key of \\(test/c.cache\\).lookup is the address of new variable"
+
+	c.put(&entry{}, 0)
+
+	register("e", &entry{}) // want "The entry of test/c.register"
+
+	register("e", nil)
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package e
+
+import "errors"
+
+type empty struct{}
+
+type emptyError struct{}
+
+func (*emptyError) Error() string { return "empty" }
+
+type emptyIsError struct{}
+
+func (*emptyIsError) Error() string { return "empty is" }
+
+func (e *emptyIsError) Is(target error) bool { // want Is:"matches any target"
+	return target == error(e) || target.Error() == e.Error() // want "is undefined"
+}
+
+type nonEmpty struct{ _ int }
+
+var (
+	ErrEmpty   = &emptyError{}
+	ErrEmptyIs = &emptyIsError{}
+)
+
+func ZeroSized(a, b *empty, c, d *nonEmpty, err error) {
+	_ = a == b // want `Result of comparison of "a" with "b" is undefined, pointers to zero-sized type "empty" may or may not be equal`
+
+	_ = a != b // want "is undefined"
+
+	_ = a == a
+
+	_ = a == nil
+
+	_ = nil != b
+
+	_ = c == d
+
+	_ = err == ErrEmpty // want "is undefined"
+
+	_ = err == error(ErrEmpty) // want "is undefined"
+
+	_ = errors.Is(err, ErrEmpty) // want "is undefined"
+
+	_ = errors.Is(err, ErrEmptyIs)
+
+	_ = a == &empty{} // want "is false or undefined"
+}
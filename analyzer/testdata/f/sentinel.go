@@ -0,0 +1,84 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package f
+
+import (
+	"errors"
+	"reflect"
+
+	"test/sentinel"
+)
+
+type skip struct{}
+
+func (*skip) Error() string { return "skip" }
+
+var errSkip = &skip{} // want `Sentinel error "errSkip" points to a new variable of zero-sized type "skip", comparisons with it may be undefined; use a value receiver for Error or a non-empty struct$` errSkip:"zero-sized sentinel test/f.skip"
+
+var errSkipError error = error(new(skip)) // want "Sentinel error" errSkipError:"zero-sized sentinel"
+
+type skipWithUnwrap struct{}
+
+func (skipWithUnwrap) Error() string { return "skip" }
+
+func (skipWithUnwrap) Unwrap() error { return nil }
+
+var errUnwrap = &skipWithUnwrap{} // want `Sentinel error "errUnwrap" .*; use the value instead of its address$` errUnwrap:"zero-sized sentinel"
+
+type skipWithIs struct{}
+
+func (skipWithIs) Error() string { return "skip" }
+
+func (skipWithIs) Is(err error) bool { // want Is:"matches \\*test/f.skipWithIs"
+	_, ok := err.(*skipWithIs)
+
+	return ok
+}
+
+var errSkipIs = &skipWithIs{}
+
+func returnsSkip(fail bool) error {
+	if fail {
+		return &skip{} // want `Returned error is the address of a new variable of zero-sized type "skip", comparisons with it may be undefined; use a value receiver for Error or a non-empty struct$`
+	}
+
+	return nil
+}
+
+func returnsSkipWithUnwrap() error {
+	return &skipWithUnwrap{} // want `Returned error is the address .* type "skipWithUnwrap", .*; use the value instead of its address$`
+}
+
+func newSkip() *skip { return &skip{} }
+
+func skipValue() reflect.Value { return reflect.ValueOf(&skip{}) }
+
+func returnsSkips() (*skip, error) {
+	return &skip{}, &skip{} // want `Returned error is the address`
+}
+
+func Sentinels(err error) {
+	_ = errors.Is(err, sentinel.ErrSkip) // want `Result of comparison of "err" with sentinel "sentinel.ErrSkip" may be undefined, it points to a variable of zero-sized type "test/sentinel.Skip"`
+
+	_ = err == sentinel.ErrSkip // want "with sentinel"
+
+	_ = sentinel.ErrSkip == err // want "with sentinel"
+
+	_ = errors.Is(sentinel.ErrSkip, err)
+
+	_ = errors.Is(err, errSkip)
+}
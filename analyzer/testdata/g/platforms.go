@@ -0,0 +1,35 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package g
+
+import "sync/atomic"
+
+type buffer [bufferSize]byte
+
+type hostOnly [hostSize]byte
+
+type plain struct{ _ int }
+
+func Platforms(b *buffer, h *hostOnly, p *plain, a *atomic.Pointer[buffer]) {
+	_ = b == &buffer{} // want `is false or undefined \(zero-sized on js/wasm\)`
+
+	_ = h == &hostOnly{} // want `is false or undefined \(zero-sized on [a-z0-9]+/[a-z0-9]+\)`
+
+	_ = p == &plain{} // want `is always false$`
+
+	_ = a.CompareAndSwap(&buffer{}, nil) // want `the swap may never happen \(zero-sized on js/wasm\)`
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package g_test
+
+import (
+	"testing"
+
+	"test/g"
+)
+
+func TestZeroSized(t *testing.T) {
+	b1, b2 := new(g.Buffer), new(g.Buffer)
+
+	if equal(b1, b2) {
+		t.Log("zero-sized")
+	}
+}
+
+func equal(b1, b2 *g.Buffer) bool {
+	return b1 == b2 // want `is undefined, pointers to zero-sized type "test/g.Buffer" may or may not be equal \(zero-sized on js/wasm\)`
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package g
+
+// Buffer is zero-sized on js/wasm.
+type Buffer [bufferSize]byte
+
+func ZeroSized(b1, b2 *buffer, h1, h2 *hostOnly, p1, p2 *plain) {
+	_ = b1 == b2 // want `is undefined, pointers to zero-sized type "buffer" may or may not be equal \(zero-sized on js/wasm\)`
+
+	_ = h1 == h2 // want `is undefined, pointers to zero-sized type "hostOnly" may or may not be equal \(zero-sized on [a-z0-9]+/[a-z0-9]+\)`
+
+	_ = p1 == p2
+
+	_ = b1 == nil
+}
@@ -3,16 +3,41 @@ module test
 go 1.25.0
 
 require (
+	github.com/cockroachdb/errors v1.12.0
+	github.com/frankban/quicktest v1.14.6
+	github.com/go-quicktest/qt v1.101.0
+	github.com/google/go-cmp v0.7.0
+	github.com/hashicorp/golang-lru/v2 v2.0.7
+	github.com/matryer/is v1.4.1
+	github.com/onsi/gomega v1.42.1
 	github.com/pkg/errors v0.9.1
+	github.com/smarty/assertions v1.15.0
+	github.com/smartystreets/goconvey v1.8.1
 	github.com/stretchr/testify v1.11.1
+	golang.org/x/exp v0.0.0-20260218203240-3dfff04db8fa
 	golang.org/x/exp/errors v0.0.0-20260218203240-3dfff04db8fa
 	golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da
 	gotest.tools/v3 v3.5.2
 )
 
 require (
+	github.com/cockroachdb/logtags v0.0.0-20230118201751-21c54148d20b // indirect
+	github.com/cockroachdb/redact v1.1.5 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/google/go-cmp v0.7.0 // indirect
+	github.com/getsentry/sentry-go v0.27.0 // indirect
+	github.com/gogo/protobuf v1.3.2 // indirect
+	github.com/gopherjs/gopherjs v1.17.2 // indirect
+	github.com/jtolds/gls v4.20.0+incompatible // indirect
+	github.com/kr/pretty v0.3.1 // indirect
+	github.com/kr/text v0.2.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rogpeppe/go-internal v1.11.0 // indirect
+	go.yaml.in/yaml/v3 v3.0.4 // indirect
+	golang.org/x/mod v0.36.0 // indirect
+	golang.org/x/net v0.56.0 // indirect
+	golang.org/x/sync v0.21.0 // indirect
+	golang.org/x/sys v0.46.0 // indirect
+	golang.org/x/text v0.38.0 // indirect
+	golang.org/x/tools v0.45.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
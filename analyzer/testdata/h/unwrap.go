@@ -0,0 +1,41 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package h
+
+import "errors"
+
+type wrappedError struct{ cause error }
+
+func (e *wrappedError) Error() string { return "wrapped: " + e.cause.Error() }
+
+func (e *wrappedError) Unwrap() error { return e.cause }
+
+type customError struct{ _ int }
+
+func (e *customError) Error() string { return "custom error" }
+
+func (e *customError) Is(target error) bool {
+	_, ok := target.(*customError)
+
+	return ok
+}
+
+func CheckUnwrap(err error) {
+	_ = errors.Is(&wrappedError{}, err)
+
+	_ = errors.Is(err, &customError{}) // want "is always false"
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package iserrors
+
+import "errors"
+
+type CodeError struct{ Code int }
+
+func (e *CodeError) Error() string { return "code error" }
+
+func (e *CodeError) Is(target error) bool {
+	var t *CodeError
+	if !errors.As(target, &t) {
+		return false
+	}
+
+	return t.Code == e.Code
+}
+
+type IdentityError struct{ _ int }
+
+func (e *IdentityError) Error() string { return "identity error" }
+
+func (e *IdentityError) Is(target error) bool { return target == e }
+
+type AnyError struct{ _ int }
+
+func (e *AnyError) Error() string { return "any error" }
+
+func (e *AnyError) Is(target error) bool { return target.Error() == e.Error() }
+
+type TimeoutError struct{ _ int }
+
+func (e *TimeoutError) Error() string { return "timeout" }
+
+type ContextError struct{ _ int }
+
+func (e ContextError) Error() string { return "context" }
+
+func (e ContextError) Is(target error) bool {
+	_, ok := target.(*TimeoutError)
+
+	return ok
+}
+
+type GenericError[P any] struct{ Value P }
+
+func (e *GenericError[P]) Error() string { return "generic error" }
+
+func (e *GenericError[P]) Is(target error) bool {
+	_, ok := target.(*GenericError[P])
+
+	return ok
+}
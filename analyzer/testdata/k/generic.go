@@ -0,0 +1,33 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package k
+
+type empty struct{}
+
+func IsNew[T any](p *T) bool { // want IsNew:"undefined with zero-sized type parameters \\[0\\]"
+	return p == new(T) // want `Result of comparison of "p" with address of new variable of type "T" is false, or undefined if instantiated with zero-sized T$`
+}
+
+func Instances[T any](p *T) {
+	_ = IsNew(&empty{})
+
+	_ = IsNew[struct{}](nil)
+
+	_ = IsNew(new(int))
+
+	_ = IsNew(p) // Instantiations of Instances are not propagated.
+}
@@ -22,7 +22,7 @@ import (
 )
 
 // errorIsInterface holds a reference to the `interface{ Is(error) bool }` type.
-// This is used by [shouldSuppressDiagnostic] to check if a type implements
+// This is used by [pass.shouldSuppressDiagnostic] to check if a type implements
 // the optional error comparison interface defined by `errors.Is`.
 //
 //nolint:gochecknoglobals
@@ -37,11 +37,12 @@ var (
 	errorUnwrapArrayInterface = newErrorUnwrapArrayInterface()
 )
 
+// isMethodName is the name of the optional error comparison method.
+const isMethodName = "Is"
+
 // newErrorIsInterface constructs and returns a new [types.Interface] representing
 // the `interface{ Is(error) bool }` type.
 func newErrorIsInterface() *types.Interface {
-	const isMethodName = "Is"
-
 	var noPkg *types.Package
 
 	params := singleVar(errorType())
@@ -16,44 +16,87 @@
 
 package analyzer
 
-import "go/types"
+import (
+	"go/build"
+	"go/types"
+
+	xtypeutil "golang.org/x/tools/go/types/typeutil"
+)
 
 // IsZeroSized determines whether the type t is provably zero-sized.
+// Type parameters are not provably zero-sized, since they could be instantiated with any type.
 func IsZeroSized(t types.Type) bool {
-	const (
-		// initialStackCapacity is the initial capacity for the type traversal stack
-		// This reduces allocations for most common cases.
-		initialStackCapacity = 10
-
-		// maxIterations protects from recusive types (should not happen) and types
-		// that are too costly to evaluate.
-		maxIterations = 100
-	)
-
-	store := [initialStackCapacity]types.Type{t}
-	stack := store[:1]
-
-	for budget := maxIterations; budget > 0 && len(stack) > 0; budget-- {
-		var top types.Type
-		switch top, stack = stack[len(stack)-1], stack[:len(stack)-1]; u := top.Underlying().(type) {
-		case *types.Array:
-			// An array is zero-sized if its length is 0 or its element type is zero-sized.
-			if u.Len() > 0 {
-				stack = append(stack, u.Elem())
-			}
+	return newZeroSizer(nil).isZeroSized(t)
+}
 
-		case *types.Struct:
-			// A struct is zero-sized if all its fields are zero-sized.
-			for field := range u.Fields() {
-				stack = append(stack, field.Type())
-			}
+// zeroSizer determines whether types are zero-sized, memoizing the results by type identity.
+type zeroSizer struct {
+	sizes types.Sizes
+	memo  xtypeutil.Map
+}
+
+// newZeroSizer returns a [zeroSizer] using sizes, or the gc sizes for the default architecture when sizes is nil.
+func newZeroSizer(sizes types.Sizes) *zeroSizer {
+	if sizes == nil {
+		sizes = types.SizesFor("gc", build.Default.GOARCH)
+	}
+
+	if sizes == nil {
+		sizes = types.SizesFor("gc", "amd64")
+	}
+
+	return &zeroSizer{sizes: sizes}
+}
+
+// isZeroSized determines whether the type t is provably zero-sized.
+//
+// Arrays and structs are decomposed, so that types containing type parameters are handled
+// without calling [types.Sizes.Sizeof], which does not support them. Struct types can not
+// contain themselves, so the recursion terminates.
+func (z *zeroSizer) isZeroSized(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	if zero, ok := z.memo.At(t).(bool); ok {
+		return zero
+	}
+
+	var zero bool
 
-		default:
-			// Other types (Basic, Chan, Interface, Map, Pointer, Signature, Slice, TypeParam)
-			// are not zero-sized.
-			return false
+	switch u := t.Underlying().(type) {
+	case *types.Array:
+		// An array is zero-sized if its length is 0 or its element type is zero-sized.
+		zero = u.Len() == 0 || z.isZeroSized(u.Elem())
+
+	case *types.Struct:
+		// A struct is zero-sized if all its fields are zero-sized.
+		zero = true
+		for field := range u.Fields() {
+			if !z.isZeroSized(field.Type()) {
+				zero = false
+
+				break
+			}
 		}
+
+	case *types.Interface:
+		// Type parameters (with interface constraints as underlying type) and interfaces are not zero-sized.
+
+	case *types.Basic:
+		// Untyped and invalid types have no size.
+		zero = u.Info()&types.IsUntyped == 0 && u.Kind() != types.Invalid && z.sizes.Sizeof(u) == 0
+
+	default:
+		zero = z.sizes.Sizeof(u) == 0
 	}
 
-	return len(stack) == 0 // All types are zero-sized
+	z.memo.Set(t, zero)
+
+	return zero
+}
+
+// isZeroSized determines whether the type t is provably zero-sized, using the sizes of the pass.
+func (p pass) isZeroSized(t types.Type) bool {
+	return p.zeroSizer.isZeroSized(t)
 }
@@ -0,0 +1,152 @@
+// Copyright 2025 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer //nolint:testpackage
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"math/rand/v2"
+	"testing"
+)
+
+// typeGenerator generates random array and struct types for property-style tests.
+type typeGenerator struct {
+	rnd   *rand.Rand
+	pkg   *types.Package
+	count int
+}
+
+func (g *typeGenerator) generate(depth int) types.Type {
+	leaves := [...]types.Type{
+		types.Typ[types.Int], types.Typ[types.Bool], types.Typ[types.String], types.Typ[types.Uint8],
+		types.NewStruct(nil, nil), types.NewPointer(types.Typ[types.Int]), types.NewSlice(types.Typ[types.Int]),
+		types.NewArray(types.Typ[types.Int], 0), types.NewArray(types.NewStruct(nil, nil), 2),
+		types.NewInterfaceType(nil, nil), types.NewMap(types.Typ[types.String], types.Typ[types.Int]),
+	}
+
+	if depth == 0 {
+		return leaves[g.rnd.IntN(len(leaves))]
+	}
+
+	switch g.rnd.IntN(4) {
+	case 0:
+		return leaves[g.rnd.IntN(len(leaves))]
+
+	case 1:
+		return types.NewArray(g.generate(depth-1), int64(g.rnd.IntN(3)))
+
+	case 2:
+		fields := make([]*types.Var, g.rnd.IntN(4))
+		for i := range fields {
+			fields[i] = types.NewField(token.NoPos, g.pkg, fmt.Sprintf("F%d", i), g.generate(depth-1), false)
+		}
+
+		return types.NewStruct(fields, nil)
+
+	default:
+		g.count++
+		obj := types.NewTypeName(token.NoPos, g.pkg, fmt.Sprintf("T%d", g.count), nil)
+
+		return types.NewNamed(obj, g.generate(depth-1).Underlying(), nil)
+	}
+}
+
+func TestIsZeroSizedSizeof(t *testing.T) {
+	t.Parallel()
+
+	sizes := types.SizesFor("gc", "amd64")
+	z := newZeroSizer(sizes)
+	g := typeGenerator{rnd: rand.New(rand.NewPCG(1, 2)), pkg: types.NewPackage("example.com/p", "p")} //nolint:gosec
+
+	for range 10_000 {
+		typ := g.generate(5)
+
+		if got, want := z.isZeroSized(typ), sizes.Sizeof(typ) == 0; got != want {
+			t.Errorf("isZeroSized(%s) = %t, want %t", typ, got, want)
+		}
+	}
+}
+
+func TestIsZeroSizedLarge(t *testing.T) {
+	t.Parallel()
+
+	fields := make([]*types.Var, 1_000)
+	for i := range fields {
+		fields[i] = types.NewField(token.NoPos, nil, fmt.Sprintf("F%d", i), types.NewStruct(nil, nil), false)
+	}
+
+	if typ := types.NewStruct(fields, nil); !IsZeroSized(typ) {
+		t.Errorf("IsZeroSized(struct with %d zero-sized fields) = false, want true", len(fields))
+	}
+}
+
+func TestIsZeroSizedTypeParam(t *testing.T) {
+	t.Parallel()
+
+	obj := types.NewTypeName(token.NoPos, nil, "T", nil)
+	tparam := types.NewTypeParam(obj, types.NewInterfaceType(nil, nil))
+
+	testCases := [...]struct {
+		name string
+		typ  types.Type
+		want bool
+	}{
+		{"type parameter", tparam, false},
+		{"struct field", types.NewStruct([]*types.Var{types.NewField(token.NoPos, nil, "F", tparam, false)}, nil), false},
+		{"empty array", types.NewArray(tparam, 0), true},
+		{"array", types.NewArray(tparam, 1), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsZeroSized(tc.typ); got != tc.want {
+				t.Errorf("IsZeroSized(%s) = %t, want %t", tc.typ, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsZeroSizedUntyped(t *testing.T) {
+	t.Parallel()
+
+	invalid := types.Typ[types.Invalid]
+
+	testCases := [...]struct {
+		name string
+		typ  types.Type
+	}{
+		{"untyped int", types.Typ[types.UntypedInt]},
+		{"untyped nil", types.Typ[types.UntypedNil]},
+		{"untyped bool", types.Typ[types.UntypedBool]},
+		{"invalid", invalid},
+		{"array of invalid", types.NewArray(invalid, 1)},
+		{"struct field", types.NewStruct([]*types.Var{types.NewField(token.NoPos, nil, "F", invalid, false)}, nil)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if IsZeroSized(tc.typ) {
+				t.Errorf("IsZeroSized(%s) = true, want false", tc.typ)
+			}
+		})
+	}
+}
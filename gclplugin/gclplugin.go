@@ -47,7 +47,8 @@ func (Plugin) GetLoadMode() string {
 
 // BuildAnalyzers returns the [analysis.Analyzer]s for a cmplint run.
 func (p Plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
-	opts := p.settings.Options()
+	// golangci-lint handles //nolint comments itself.
+	opts := append(p.settings.Options(), cmplint.WithNolint(false))
 	a := cmplint.New(opts...)
 
 	return []*analysis.Analyzer{a}, nil
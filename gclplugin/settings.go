@@ -20,7 +20,14 @@ import cmplint "fillmore-labs.com/cmplint/analyzer"
 
 // Settings represents the configuration options for an instance of the [Plugin].
 type Settings struct {
-	CheckIs *bool `json:"check-is,omitzero"`
+	CheckIs        *bool    `json:"check-is,omitzero"`
+	CheckUnwrap    *bool    `json:"check-unwrap,omitzero"`
+	CheckZeroSized *bool    `json:"check-zero-sized,omitzero"`
+	CheckSentinels *bool    `json:"check-sentinels,omitzero"`
+	AllowTypes     []string `json:"allow-types,omitzero"`
+	Platforms      []string `json:"platforms,omitzero"`
+	Audit          *bool    `json:"audit,omitzero"`
+	KeyedFunctions []string `json:"keyed,omitzero"`
 }
 
 // Options converts [Settings] into a list of [cmplint.Option] for the cmplint analyzer.
@@ -29,6 +36,22 @@ func (s Settings) Options() []cmplint.Option {
 	var opts []cmplint.Option
 
 	opts = appendOption(opts, s.CheckIs, cmplint.WithCheckIs)
+	opts = appendOption(opts, s.CheckUnwrap, cmplint.WithCheckUnwrap)
+	opts = appendOption(opts, s.CheckZeroSized, cmplint.WithCheckZeroSized)
+	opts = appendOption(opts, s.CheckSentinels, cmplint.WithCheckSentinels)
+	opts = appendOption(opts, s.Audit, cmplint.WithAudit)
+
+	if len(s.AllowTypes) > 0 {
+		opts = append(opts, cmplint.WithAllowedTypes(s.AllowTypes...))
+	}
+
+	if len(s.Platforms) > 0 {
+		opts = append(opts, cmplint.WithPlatforms(s.Platforms...))
+	}
+
+	if len(s.KeyedFunctions) > 0 {
+		opts = append(opts, cmplint.WithKeyedFunctions(s.KeyedFunctions...))
+	}
 
 	return opts
 }
@@ -26,7 +26,14 @@ import (
 )
 
 const allSettings = `{
-	"check-is": true
+	"check-is": true,
+	"check-unwrap": true,
+	"check-zero-sized": true,
+	"check-sentinels": true,
+	"allow-types": ["example.com/intern.Symbol"],
+	"platforms": ["js/wasm"],
+	"audit": false,
+	"keyed": ["(example.com/cache.Cache).Get:0=key"]
 }`
 
 func TestSettings(t *testing.T) {
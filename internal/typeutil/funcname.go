@@ -17,6 +17,8 @@
 package typeutil
 
 import (
+	"errors"
+	"go/token"
 	"go/types"
 	"strings"
 )
@@ -66,6 +68,44 @@ func (f FuncName) String() string {
 	return sb.String()
 }
 
+// ErrInvalidFuncName is returned by [ParseFuncName] for malformed function names.
+var ErrInvalidFuncName = errors.New("invalid function name")
+
+// ParseFuncName parses a fully qualified function name in the format produced by
+// [FuncName.String], "<path>.<name>" for functions or "(<path>.<receiver>).<name>"
+// for methods. A pointer receiver "(*<path>.<receiver>).<name>" is accepted too.
+func ParseFuncName(s string) (FuncName, error) {
+	var f FuncName
+
+	if rest, ok := strings.CutPrefix(s, "("); ok { // A method.
+		recv, name, ok := strings.Cut(rest, ").")
+		if !ok {
+			return FuncName{}, ErrInvalidFuncName
+		}
+
+		recv = strings.TrimPrefix(recv, "*")
+		if i := strings.LastIndexByte(recv, '.'); i >= 0 {
+			f.Path, recv = recv[:i], recv[i+1:]
+		}
+
+		if !token.IsIdentifier(recv) {
+			return FuncName{}, ErrInvalidFuncName
+		}
+
+		f.Receiver, s = recv, name
+	} else if i := strings.LastIndexByte(s, '.'); i >= 0 { // A regular function.
+		f.Path, s = s[:i], s[i+1:]
+	}
+
+	if !token.IsIdentifier(s) {
+		return FuncName{}, ErrInvalidFuncName
+	}
+
+	f.Name = s
+
+	return f, nil
+}
+
 // NewFuncName extracts the name components of a given *types.Func.
 // It populates a FuncName struct, which is simplified and canonicalized
 // from fun.Fullname() and can then be used as a map index or to get a
@@ -89,7 +129,7 @@ func NewFuncName(fun *types.Func) FuncName {
 recvloop:
 	switch t := rtyp.(type) {
 	case *types.Alias:
-		rtyp = t.Rhs() // Unwrap alias.
+		rtyp = types.Unalias(t) // Unwrap alias chains, including instantiated generic aliases.
 		goto recvloop
 
 	case *types.Pointer:
@@ -19,6 +19,7 @@ package typeutil_test
 import (
 	"go/token"
 	"go/types"
+	"strings"
 	"testing"
 
 	. "fillmore-labs.com/cmplint/internal/typeutil"
@@ -67,6 +68,29 @@ func TestNewFuncName(t *testing.T) {
 			}(),
 			wantFuncName: "(example.com/testpkg.MyType).myFunc",
 		},
+		{
+			name: "alias method call",
+			fun: func() *types.Func {
+				alias := types.NewAlias(types.NewTypeName(token.NoPos, pkg, "MyAlias", nil), named)
+				aliasAlias := types.NewAlias(types.NewTypeName(token.NoPos, pkg, "MyAliasAlias", nil), alias)
+				recv := types.NewVar(token.NoPos, pkg, "", aliasAlias)
+				sig := types.NewSignatureType(recv, nil, nil, nil, nil, false)
+
+				return types.NewFunc(token.NoPos, pkg, "myFunc", sig)
+			}(),
+			wantFuncName: "(example.com/testpkg.MyType).myFunc",
+		},
+		{
+			name: "pointer alias method call",
+			fun: func() *types.Func {
+				alias := types.NewAlias(types.NewTypeName(token.NoPos, pkg, "MyPointer", nil), types.NewPointer(named))
+				recv := types.NewVar(token.NoPos, pkg, "", alias)
+				sig := types.NewSignatureType(recv, nil, nil, nil, nil, false)
+
+				return types.NewFunc(token.NoPos, pkg, "myFunc", sig)
+			}(),
+			wantFuncName: "(example.com/testpkg.MyType).myFunc",
+		},
 		{
 			name: "interface method call",
 			fun: func() *types.Func {
@@ -130,3 +154,44 @@ func TestNewFuncName(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFuncName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		s       string
+		want    FuncName
+		wantErr bool
+	}{
+		{"function", "example.com/testpkg.myFunc", FuncName{Path: "example.com/testpkg", Name: "myFunc"}, false},
+		{"method", "(example.com/testpkg.MyType).myFunc", FuncName{Path: "example.com/testpkg", Receiver: "MyType", Name: "myFunc"}, false},
+		{"pointer method", "(*sync.Map).Load", FuncName{Path: "sync", Receiver: "Map", Name: "Load"}, false},
+		{"function without package", "myFunc", FuncName{Name: "myFunc"}, false},
+		{"interface method", "(error).Error", FuncName{Receiver: "error", Name: "Error"}, false},
+		{"empty", "", FuncName{}, true},
+		{"missing name", "example.com/testpkg.", FuncName{}, true},
+		{"unclosed receiver", "(example.com/testpkg.MyType.myFunc", FuncName{}, true},
+		{"invalid receiver", "(<invalid>).myFunc", FuncName{}, true},
+		{"invalid name", "(sync.Map).Load()", FuncName{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseFuncName(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFuncName(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseFuncName(%q) = %#v, want %#v", tt.s, got, tt.want)
+			}
+
+			if !tt.wantErr && got.String() != strings.Replace(tt.s, "(*", "(", 1) {
+				t.Errorf("ParseFuncName(%q).String() = %q", tt.s, got)
+			}
+		})
+	}
+}